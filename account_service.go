@@ -3,16 +3,15 @@ package telegram
 import (
 	"context"
 	"net/http"
-	"telegram/common"
 )
 
 type GetMeService struct {
 	c *Client
 }
 
-func (t *GetMeService) Do(ctx context.Context, opts ...RequestOption) (res []*GetMe, err error) {
+func (t *GetMeService) Do(ctx context.Context, opts ...RequestOption) (res *User, err error) {
 	r := &request{
-		method:   http.MethodGet,
+		method:   http.MethodPost,
 		endpoint: "/getMe",
 	}
 
@@ -21,90 +20,43 @@ func (t *GetMeService) Do(ctx context.Context, opts ...RequestOption) (res []*Ge
 		return nil, err
 	}
 
-	data = common.ToJSONList(data)
-	if err != nil {
-		return nil, err
-	}
-
-	res = make([]*GetMe, 0)
-	err = json.Unmarshal(data, &res)
-
-	if err != nil {
-		return nil, err
-	}
-
-	return res, nil
-}
-
-type GetMe struct {
-	Ok     bool `json:"ok"`
-	Result User `json:"result"`
+	return decodeResult[*User](data)
 }
 
 type LogOutService struct {
 	c *Client
 }
 
-func (t *LogOutService) Do(ctx context.Context, opts ...RequestOption) (res []*LogOut, err error) {
+func (t *LogOutService) Do(ctx context.Context, opts ...RequestOption) (res bool, err error) {
 	r := &request{
-		method:   http.MethodGet,
+		method:   http.MethodPost,
 		endpoint: "/logOut",
 	}
 
 	data, err := t.c.callAPI(ctx, r, opts...)
 	if err != nil {
-		return nil, err
-	}
-
-	data = common.ToJSONList(data)
-	if err != nil {
-		return nil, err
+		return false, err
 	}
 
-	res = make([]*LogOut, 0)
-	err = json.Unmarshal(data, &res)
-
-	if err != nil {
-		return nil, err
-	}
-
-	return res, nil
-}
-
-type LogOut struct {
+	return decodeResult[bool](data)
 }
 
 type CloseService struct {
 	c *Client
 }
 
-func (t *CloseService) Do(ctx context.Context, opts ...RequestOption) (res []*Close, err error) {
+func (t *CloseService) Do(ctx context.Context, opts ...RequestOption) (res bool, err error) {
 	r := &request{
-		method:   http.MethodGet,
+		method:   http.MethodPost,
 		endpoint: "/close",
 	}
 
 	data, err := t.c.callAPI(ctx, r, opts...)
 	if err != nil {
-		return nil, err
-	}
-
-	data = common.ToJSONList(data)
-	if err != nil {
-		return nil, err
-	}
-
-	res = make([]*Close, 0)
-	err = json.Unmarshal(data, &res)
-
-	if err != nil {
-		return nil, err
+		return false, err
 	}
 
-	return res, nil
-}
-
-type Close struct {
+	return decodeResult[bool](data)
 }
 
 type SetMyNameService struct {
@@ -123,9 +75,9 @@ func (t *SetMyNameService) LanguageCode(languageCode string) *SetMyNameService {
 	return t
 }
 
-func (t *SetMyNameService) Do(ctx context.Context, opts ...RequestOption) (res []*SetMyName, err error) {
+func (t *SetMyNameService) Do(ctx context.Context, opts ...RequestOption) (res bool, err error) {
 	r := &request{
-		method:   http.MethodGet,
+		method:   http.MethodPost,
 		endpoint: "/setMyName",
 	}
 
@@ -138,27 +90,10 @@ func (t *SetMyNameService) Do(ctx context.Context, opts ...RequestOption) (res [
 
 	data, err := t.c.callAPI(ctx, r, opts...)
 	if err != nil {
-		return nil, err
-	}
-
-	data = common.ToJSONList(data)
-	if err != nil {
-		return nil, err
+		return false, err
 	}
 
-	res = make([]*SetMyName, 0)
-	err = json.Unmarshal(data, &res)
-
-	if err != nil {
-		return nil, err
-	}
-
-	return res, nil
-}
-
-type SetMyName struct {
-	Ok     bool `json:"ok"`
-	Result bool `json:"result"`
+	return decodeResult[bool](data)
 }
 
 type GetMyNameService struct {
@@ -171,9 +106,9 @@ func (t *GetMyNameService) LanguageCode(languageCode string) *GetMyNameService {
 	return t
 }
 
-func (t *GetMyNameService) Do(ctx context.Context, opts ...RequestOption) (res []*GetMyName, err error) {
+func (t *GetMyNameService) Do(ctx context.Context, opts ...RequestOption) (res *BotName, err error) {
 	r := &request{
-		method:   http.MethodGet,
+		method:   http.MethodPost,
 		endpoint: "/getMyName",
 	}
 
@@ -186,24 +121,7 @@ func (t *GetMyNameService) Do(ctx context.Context, opts ...RequestOption) (res [
 		return nil, err
 	}
 
-	data = common.ToJSONList(data)
-	if err != nil {
-		return nil, err
-	}
-
-	res = make([]*GetMyName, 0)
-	err = json.Unmarshal(data, &res)
-
-	if err != nil {
-		return nil, err
-	}
-
-	return res, nil
-}
-
-type GetMyName struct {
-	Ok     bool `json:"ok"`
-	Result bool `json:"result"`
+	return decodeResult[*BotName](data)
 }
 
 type SetMyDescriptionService struct {
@@ -222,9 +140,9 @@ func (t *SetMyDescriptionService) LanguageCode(languageCode string) *SetMyDescri
 	return t
 }
 
-func (t *SetMyDescriptionService) Do(ctx context.Context, opts ...RequestOption) (res []*SetMyDescription, err error) {
+func (t *SetMyDescriptionService) Do(ctx context.Context, opts ...RequestOption) (res bool, err error) {
 	r := &request{
-		method:   http.MethodGet,
+		method:   http.MethodPost,
 		endpoint: "/setMyDescription",
 	}
 
@@ -237,27 +155,10 @@ func (t *SetMyDescriptionService) Do(ctx context.Context, opts ...RequestOption)
 
 	data, err := t.c.callAPI(ctx, r, opts...)
 	if err != nil {
-		return nil, err
-	}
-
-	data = common.ToJSONList(data)
-	if err != nil {
-		return nil, err
-	}
-
-	res = make([]*SetMyDescription, 0)
-	err = json.Unmarshal(data, &res)
-
-	if err != nil {
-		return nil, err
+		return false, err
 	}
 
-	return res, nil
-}
-
-type SetMyDescription struct {
-	Ok     bool `json:"ok"`
-	Result bool `json:"result"`
+	return decodeResult[bool](data)
 }
 
 type GetMyDescriptionService struct {
@@ -270,9 +171,9 @@ func (t *GetMyDescriptionService) LanguageCode(languageCode string) *GetMyDescri
 	return t
 }
 
-func (t *GetMyDescriptionService) Do(ctx context.Context, opts ...RequestOption) (res []*GetMyDescription, err error) {
+func (t *GetMyDescriptionService) Do(ctx context.Context, opts ...RequestOption) (res *BotDescription, err error) {
 	r := &request{
-		method:   http.MethodGet,
+		method:   http.MethodPost,
 		endpoint: "/getMyDescription",
 	}
 
@@ -285,24 +186,7 @@ func (t *GetMyDescriptionService) Do(ctx context.Context, opts ...RequestOption)
 		return nil, err
 	}
 
-	data = common.ToJSONList(data)
-	if err != nil {
-		return nil, err
-	}
-
-	res = make([]*GetMyDescription, 0)
-	err = json.Unmarshal(data, &res)
-
-	if err != nil {
-		return nil, err
-	}
-
-	return res, nil
-}
-
-type GetMyDescription struct {
-	Ok     bool `json:"ok"`
-	Result bool `json:"result"`
+	return decodeResult[*BotDescription](data)
 }
 
 type SetMyShortDescriptionService struct {
@@ -321,9 +205,9 @@ func (t *SetMyShortDescriptionService) LanguageCode(languageCode string) *SetMyS
 	return t
 }
 
-func (t *SetMyShortDescriptionService) Do(ctx context.Context, opts ...RequestOption) (res []*SetMyShortDescription, err error) {
+func (t *SetMyShortDescriptionService) Do(ctx context.Context, opts ...RequestOption) (res bool, err error) {
 	r := &request{
-		method:   http.MethodGet,
+		method:   http.MethodPost,
 		endpoint: "/setMyShortDescription",
 	}
 
@@ -336,27 +220,10 @@ func (t *SetMyShortDescriptionService) Do(ctx context.Context, opts ...RequestOp
 
 	data, err := t.c.callAPI(ctx, r, opts...)
 	if err != nil {
-		return nil, err
-	}
-
-	data = common.ToJSONList(data)
-	if err != nil {
-		return nil, err
-	}
-
-	res = make([]*SetMyShortDescription, 0)
-	err = json.Unmarshal(data, &res)
-
-	if err != nil {
-		return nil, err
+		return false, err
 	}
 
-	return res, nil
-}
-
-type SetMyShortDescription struct {
-	Ok     bool `json:"ok"`
-	Result bool `json:"result"`
+	return decodeResult[bool](data)
 }
 
 type GetMyShortDescriptionService struct {
@@ -369,9 +236,9 @@ func (t *GetMyShortDescriptionService) LanguageCode(languageCode string) *GetMyS
 	return t
 }
 
-func (t *GetMyShortDescriptionService) Do(ctx context.Context, opts ...RequestOption) (res []*GetMyShortDescription, err error) {
+func (t *GetMyShortDescriptionService) Do(ctx context.Context, opts ...RequestOption) (res *BotShortDescription, err error) {
 	r := &request{
-		method:   http.MethodGet,
+		method:   http.MethodPost,
 		endpoint: "/getMyShortDescription",
 	}
 
@@ -384,22 +251,5 @@ func (t *GetMyShortDescriptionService) Do(ctx context.Context, opts ...RequestOp
 		return nil, err
 	}
 
-	data = common.ToJSONList(data)
-	if err != nil {
-		return nil, err
-	}
-
-	res = make([]*GetMyShortDescription, 0)
-	err = json.Unmarshal(data, &res)
-
-	if err != nil {
-		return nil, err
-	}
-
-	return res, nil
-}
-
-type GetMyShortDescription struct {
-	Ok     bool `json:"ok"`
-	Result bool `json:"result"`
+	return decodeResult[*BotShortDescription](data)
 }
@@ -0,0 +1,120 @@
+package telegram
+
+import (
+	"context"
+	stdjson "encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+// Call invokes any Bot API method, including ones without a service in this
+// package, and returns its raw result. It goes through the same middleware,
+// rate limiting, retries and error handling as the services. See Invoke for
+// the accepted params.
+func (c *Client) Call(ctx context.Context, method string, params any, opts ...RequestOption) (stdjson.RawMessage, error) {
+	return Invoke[stdjson.RawMessage](ctx, c, method, params, opts...)
+}
+
+// Invoke calls a Bot API method and decodes its result into T, e.g.
+//
+//	ok, err := telegram.Invoke[bool](ctx, client, "setMessageReaction", map[string]any{
+//		"chat_id":    chatID,
+//		"message_id": messageID,
+//		"reaction":   []map[string]string{{"type": "emoji", "emoji": "👍"}},
+//	})
+//
+// params is nil, a map with string keys or a struct, whose fields are named
+// by their json tags and skipped when tagged omitempty and zero. InputFile
+// values at the top level are uploaded.
+func Invoke[T any](ctx context.Context, c *Client, method string, params any, opts ...RequestOption) (res T, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/" + strings.TrimPrefix(method, "/"),
+	}
+	err = r.setAny(params)
+	if err != nil {
+		return res, err
+	}
+
+	data, err := c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return res, err
+	}
+	return decodeResult[T](data)
+}
+
+// setAny adds the entries of a map or the fields of a struct as parameters.
+func (r *request) setAny(value any) error {
+	if value == nil {
+		return nil
+	}
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return fmt.Errorf("params must have string keys, got %s", v.Type())
+		}
+		iter := v.MapRange()
+		for iter.Next() {
+			r.setValue(iter.Key().String(), iter.Value().Interface())
+		}
+	case reflect.Struct:
+		r.setStruct(v)
+	default:
+		return fmt.Errorf("params must be a map or a struct, got %s", v.Type())
+	}
+	return nil
+}
+
+func (r *request) setStruct(v reflect.Value) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		tagName, options, _ := strings.Cut(tag, ",")
+
+		fv := v.Field(i)
+		if field.Anonymous && tagName == "" && fv.Kind() == reflect.Struct {
+			r.setStruct(fv)
+			continue
+		}
+		name := field.Name
+		if tagName != "" {
+			name = tagName
+		}
+		omitEmpty := strings.Contains(","+options+",", ",omitempty,")
+		if omitEmpty && fv.IsZero() {
+			continue
+		}
+		r.setValue(name, fv.Interface())
+	}
+}
+
+func (r *request) setValue(key string, value any) {
+	if isNil(value) {
+		return
+	}
+	switch file := value.(type) {
+	case InputFile:
+		r.setFile(key, file)
+	case *InputFile:
+		r.setFile(key, *file)
+	default:
+		r.setParam(key, value)
+	}
+}
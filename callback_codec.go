@@ -0,0 +1,186 @@
+package telegram
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// MaxCallbackDataSize is the largest callback_data Telegram accepts, in
+// bytes.
+const MaxCallbackDataSize = 64
+
+// callbackMACSize is the number of HMAC-SHA256 bytes kept in callback data;
+// 8 bytes make forging a button impractical while leaving room for fields.
+const callbackMACSize = 8
+
+const callbackSeparator = "|"
+
+var (
+	ErrCallbackDataTooLong = errors.New("callback data is longer than 64 bytes")
+	ErrCallbackDataInvalid = errors.New("callback data is malformed or was tampered with")
+	ErrCallbackVersion     = errors.New("callback data has an unexpected version")
+)
+
+var callbackEscaper = strings.NewReplacer("%", "%25", "|", "%7C")
+var callbackUnescaper = strings.NewReplacer("%7C", "|", "%25", "%")
+
+// CallbackCodec encodes structs into signed callback data of the form
+// prefix|version|field...|mac. Fields are written in declaration order, so
+// adding, removing or reordering fields requires a new version. Supported
+// field kinds are strings, booleans and integers.
+type CallbackCodec struct {
+	secret []byte
+}
+
+// NewCallbackCodec returns a codec that signs data with secret, which should
+// be at least 32 random bytes kept private to the bot.
+func NewCallbackCodec(secret []byte) *CallbackCodec {
+	return &CallbackCodec{secret: secret}
+}
+
+// Encode returns the callback data for payload, a struct or a pointer to one,
+// or nil for buttons without fields.
+func (c *CallbackCodec) Encode(prefix string, version int, payload any) (string, error) {
+	if prefix == "" || strings.Contains(prefix, callbackSeparator) {
+		return "", fmt.Errorf("callback prefix %q must be non-empty and must not contain %q", prefix, callbackSeparator)
+	}
+
+	parts := []string{prefix, strconv.Itoa(version)}
+	// A nil payload, or a nil pointer to a struct, has no fields to encode.
+	v := reflect.Indirect(reflect.ValueOf(payload))
+	if v.IsValid() {
+		if v.Kind() != reflect.Struct {
+			return "", fmt.Errorf("callback payload must be a struct, got %s", v.Type())
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if !v.Type().Field(i).IsExported() {
+				continue
+			}
+			field, err := encodeCallbackField(v.Field(i))
+			if err != nil {
+				return "", fmt.Errorf("callback field %s: %w", v.Type().Field(i).Name, err)
+			}
+			parts = append(parts, field)
+		}
+	}
+
+	data := strings.Join(parts, callbackSeparator)
+	data += callbackSeparator + c.mac(data)
+	if len(data) > MaxCallbackDataSize {
+		return "", ErrCallbackDataTooLong
+	}
+	return data, nil
+}
+
+// Decode verifies data and decodes its fields into payload, a pointer to a
+// struct of the type data was encoded from.
+func (c *CallbackCodec) Decode(data string, version int, payload any) error {
+	i := strings.LastIndex(data, callbackSeparator)
+	if i < 0 {
+		return ErrCallbackDataInvalid
+	}
+	signed, mac := data[:i], data[i+1:]
+	if !hmac.Equal([]byte(mac), []byte(c.mac(signed))) {
+		return ErrCallbackDataInvalid
+	}
+
+	parts := strings.Split(signed, callbackSeparator)
+	if len(parts) < 2 {
+		return ErrCallbackDataInvalid
+	}
+	if parts[1] != strconv.Itoa(version) {
+		return ErrCallbackVersion
+	}
+	fields := parts[2:]
+
+	if payload == nil {
+		if len(fields) > 0 {
+			return ErrCallbackDataInvalid
+		}
+		return nil
+	}
+	v := reflect.ValueOf(payload)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("callback payload must be a pointer to a struct, got %T", payload)
+	}
+	v = v.Elem()
+
+	n := 0
+	for i := 0; i < v.NumField(); i++ {
+		if !v.Type().Field(i).IsExported() {
+			continue
+		}
+		if n >= len(fields) {
+			return ErrCallbackDataInvalid
+		}
+		err := decodeCallbackField(v.Field(i), fields[n])
+		if err != nil {
+			return fmt.Errorf("callback field %s: %w", v.Type().Field(i).Name, err)
+		}
+		n++
+	}
+	if n != len(fields) {
+		return ErrCallbackDataInvalid
+	}
+	return nil
+}
+
+func (c *CallbackCodec) mac(data string) string {
+	h := hmac.New(sha256.New, c.secret)
+	h.Write([]byte(data))
+	return base64.RawURLEncoding.EncodeToString(h.Sum(nil)[:callbackMACSize])
+}
+
+// CallbackPrefix returns the prefix callback data was encoded with.
+func CallbackPrefix(data string) string {
+	prefix, _, _ := strings.Cut(data, callbackSeparator)
+	return prefix
+}
+
+// Integers are written in base 36 to save space.
+func encodeCallbackField(v reflect.Value) (string, error) {
+	switch v.Kind() {
+	case reflect.String:
+		return callbackEscaper.Replace(v.String()), nil
+	case reflect.Bool:
+		if v.Bool() {
+			return "1", nil
+		}
+		return "0", nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 36), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 36), nil
+	}
+	return "", fmt.Errorf("unsupported kind %s", v.Kind())
+}
+
+func decodeCallbackField(v reflect.Value, s string) error {
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(callbackUnescaper.Replace(s))
+	case reflect.Bool:
+		v.SetBool(s == "1")
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 36, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 36, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	default:
+		return fmt.Errorf("unsupported kind %s", v.Kind())
+	}
+	return nil
+}
@@ -3,7 +3,6 @@ package telegram
 import (
 	"context"
 	"net/http"
-	"telegram/common"
 )
 
 type AnswerCallbackQueryService struct {
@@ -40,9 +39,9 @@ func (t *AnswerCallbackQueryService) CacheTime(cacheTime int64) *AnswerCallbackQ
 	return t
 }
 
-func (t *AnswerCallbackQueryService) Do(ctx context.Context, opts ...RequestOption) (res []*AnswerCallbackQuery, err error) {
+func (t *AnswerCallbackQueryService) Do(ctx context.Context, opts ...RequestOption) (res bool, err error) {
 	r := &request{
-		method:   http.MethodGet,
+		method:   http.MethodPost,
 		endpoint: "/answerCallbackQuery",
 	}
 
@@ -62,22 +61,7 @@ func (t *AnswerCallbackQueryService) Do(ctx context.Context, opts ...RequestOpti
 	data, err := t.c.callAPI(ctx, r, opts...)
 
 	if err != nil {
-		return nil, err
+		return false, err
 	}
-	data = common.ToJSONList(data)
-	if err != nil {
-		return nil, err
-	}
-
-	res = make([]*AnswerCallbackQuery, 0)
-	err = json.Unmarshal(data, &res)
-	if err != nil {
-		return nil, err
-	}
-	return res, nil
-}
-
-type AnswerCallbackQuery struct {
-	Ok     bool `json:"ok"`
-	Result bool `json:"result"`
+	return decodeResult[bool](data)
 }
@@ -0,0 +1,134 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+)
+
+// CallbackRouter dispatches callback queries by the prefix of their data and
+// answers every query its handler did not answer, so the button stops
+// showing a progress indicator. It can be used on its own as an
+// UpdateHandler or mounted with Router.CallbackQuery(cr.Handle).
+type CallbackRouter struct {
+	c        *Client
+	codec    *CallbackCodec
+	routes   []callbackRoute
+	fallback HandlerFunc
+}
+
+type callbackRoute struct {
+	prefix  string
+	handler HandlerFunc
+}
+
+// NewCallbackRouter returns a router that decodes payloads with codec, which
+// may be nil when only Prefix routes are used.
+func (c *Client) NewCallbackRouter(codec *CallbackCodec) *CallbackRouter {
+	return &CallbackRouter{c: c, codec: codec}
+}
+
+// Prefix registers handler for callback data starting with prefix.
+func (r *CallbackRouter) Prefix(prefix string, handler HandlerFunc) *CallbackRouter {
+	r.routes = append(r.routes, callbackRoute{prefix: prefix, handler: handler})
+	return r
+}
+
+// Fallback sets the handler for callback queries that match no route.
+func (r *CallbackRouter) Fallback(handler HandlerFunc) *CallbackRouter {
+	r.fallback = handler
+	return r
+}
+
+// HandleCallback registers handler for callback data encoded by the router's
+// codec with the given prefix and version, and passes it the decoded payload.
+// Data that fails verification or has another version is answered and
+// reported as an error without calling handler.
+func HandleCallback[T any](r *CallbackRouter, prefix string, version int, handler func(ctx *Context, payload T) error) *CallbackRouter {
+	return r.Prefix(prefix+callbackSeparator, func(ctx *Context) error {
+		if r.codec == nil {
+			return errors.New("callback router has no codec")
+		}
+		query := ctx.Update.CallbackQuery
+		var payload T
+		err := r.codec.Decode(query.Data, version, &payload)
+		if err != nil {
+			return fmt.Errorf("callback query %s: %w", query.ID, err)
+		}
+		return handler(ctx, payload)
+	})
+}
+
+func (r *CallbackRouter) HandleUpdate(ctx context.Context, update *Update) error {
+	return r.Handle(r.c.newContext(ctx, update))
+}
+
+func (r *CallbackRouter) Handle(ctx *Context) error {
+	query := ctx.Update.CallbackQuery
+	if query == nil {
+		return nil
+	}
+
+	answer := &callbackAnswer{c: r.c, queryID: query.ID}
+	ctx = ctx.withValue(callbackAnswerKey{}, answer)
+
+	handler := r.fallback
+	for _, route := range r.routes {
+		if strings.HasPrefix(query.Data, route.prefix) {
+			handler = route.handler
+			break
+		}
+	}
+	var err error
+	if handler != nil {
+		err = handler(ctx)
+	}
+
+	if !answer.answered.Load() {
+		_, answerErr := r.c.NewAnswerCallbackQueryService().CallbackQueryID(query.ID).Do(ctx)
+		err = errors.Join(err, answerErr)
+	}
+	return err
+}
+
+type callbackAnswerKey struct{}
+
+type callbackAnswer struct {
+	c        *Client
+	queryID  string
+	answered atomic.Bool
+}
+
+// AnswerCallback answers the callback query handled by a CallbackRouter,
+// showing text as a notification or, with showAlert, as an alert. The router
+// then skips its own answer, as it does when the handler uses Context.Answer
+// or calls answerCallbackQuery itself.
+func AnswerCallback(ctx context.Context, text string, showAlert bool) error {
+	answer, ok := ctx.Value(callbackAnswerKey{}).(*callbackAnswer)
+	if !ok {
+		return errors.New("context does not come from a CallbackRouter")
+	}
+	_, err := answer.c.NewAnswerCallbackQueryService().
+		CallbackQueryID(answer.queryID).
+		Text(text).
+		ShowAlert(showAlert).
+		Do(ctx)
+	return err
+}
+
+// noteCallbackAnswer records that the callback query handled with ctx was
+// answered by a successful call r.
+func noteCallbackAnswer(ctx context.Context, r *request) {
+	if r.endpoint != "/answerCallbackQuery" {
+		return
+	}
+	answer, ok := ctx.Value(callbackAnswerKey{}).(*callbackAnswer)
+	if !ok {
+		return
+	}
+	if queryID, ok := r.params["callback_query_id"]; ok && formatParam(queryID) == answer.queryID {
+		answer.answered.Store(true)
+	}
+}
@@ -0,0 +1,51 @@
+package telegram
+
+import (
+	"context"
+	"net/http"
+)
+
+const (
+	ChatMemberStatusCreator       = "creator"
+	ChatMemberStatusAdministrator = "administrator"
+	ChatMemberStatusMember        = "member"
+	ChatMemberStatusRestricted    = "restricted"
+	ChatMemberStatusLeft          = "left"
+	ChatMemberStatusKicked        = "kicked"
+)
+
+type GetChatMemberService struct {
+	c      *Client
+	chatID *int64
+	userID *int64
+}
+
+func (t *GetChatMemberService) ChatID(chatID int64) *GetChatMemberService {
+	t.chatID = &chatID
+	return t
+}
+
+func (t *GetChatMemberService) UserID(userID int64) *GetChatMemberService {
+	t.userID = &userID
+	return t
+}
+
+func (t *GetChatMemberService) Do(ctx context.Context, opts ...RequestOption) (res *ChatMember, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/getChatMember",
+	}
+
+	r.setParam("chat_id", *t.chatID)
+	r.setParam("user_id", *t.userID)
+
+	data, err := t.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResult[*ChatMember](data)
+}
+
+func (m *ChatMember) IsAdmin() bool {
+	return m.Status == ChatMemberStatusCreator || m.Status == ChatMemberStatusAdministrator
+}
@@ -3,89 +3,185 @@ package telegram
 import (
 	"bytes"
 	"context"
-	"crypto/tls"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"net/http"
 	"net/url"
-	"os"
-	"reflect"
+	"strings"
 	"telegram/common"
+	"time"
 
 	jsoniter "github.com/json-iterator/go"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type Client struct {
-	Token      string
-	SecretKey  string
-	BaseURL    string
+	Token     string
+	SecretKey string
+	BaseURL   string
+	// FileURL is the prefix of file download URLs, up to and including
+	// /file/bot<token>. When empty it is derived from BaseURL.
+	FileURL string
+	// LocalMode enables the semantics of a telegram-bot-api server started
+	// with --local: files given by path are sent as file:// URIs instead of
+	// being uploaded, and downloads with an absolute file_path are read from
+	// disk.
+	LocalMode  bool
 	UserAgent  string
 	HTTPClient *http.Client
-	Debug      bool
-	Logger     *log.Logger
+	// Logger receives a debug record for every call, with the token and
+	// message contents redacted. Calls are not logged when it is nil.
+	Logger     *slog.Logger
 	TimeOffset int64
-	do         doFunc
+	// UseGET sends calls without files as GET requests with the parameters in
+	// the query string, as earlier versions of this package did. By default
+	// they are sent as POST requests with a JSON body, which keeps message
+	// contents out of URLs and their length limits.
+	UseGET bool
+	// RetryPolicy enables retries of calls rejected with 429 Too Many
+	// Requests. Calls are not retried when it is nil.
+	RetryPolicy *RetryPolicy
+	// MigrateChats resends calls that fail because a group was upgraded to
+	// a supergroup, using the supergroup ID reported by Telegram.
+	MigrateChats bool
+	// OnChatMigrate is called whenever Telegram reports that a group moved
+	// to a new chat ID, whether or not MigrateChats is set. Calls naming two
+	// basic groups, such as a forward between them, are neither reported nor
+	// resent, since the error does not say which one moved.
+	OnChatMigrate func(ctx context.Context, fromChatID, toChatID int64)
+	// RateLimiter, when set, is consulted before every call that sends a
+	// message.
+	RateLimiter RateLimiter
+	// Metrics, when set, receives the latency and outcome of every call and
+	// handled update.
+	Metrics Metrics
+	// TracerProvider, when set, is used to record an OpenTelemetry span for
+	// every call and handled update.
+	TracerProvider trace.TracerProvider
+	middlewares    []Middleware
+	// httpTuned records that an option changed HTTPClient, which a later
+	// WithHTTPClient would silently discard. ownTransport records that
+	// HTTPClient.Transport is a clone that options may modify.
+	httpTuned    bool
+	ownTransport bool
 }
 
+// Deprecated: Use New with WithSecretKey.
 func NewClient(token, secretKey string) *Client {
-	return &Client{
-		Token:      token,
-		SecretKey:  secretKey,
-		UserAgent:  "Telegram/Golang",
-		BaseURL:    baseAPIMainURL + token,
-		HTTPClient: http.DefaultClient,
-		Logger:     log.New(os.Stderr, "Telegram-golang ", log.LstdFlags),
-	}
+	c := newClient(token)
+	c.SecretKey = secretKey
+	return c
 }
 
+// Deprecated: Use New with WithProxy, which reports invalid proxy URLs as
+// errors.
 func NewProxyClient(token, secretKey, proxyUrl string) *Client {
-	proxy, err := url.Parse(proxyUrl)
+	c, err := New(token, WithSecretKey(secretKey), WithProxy(proxyUrl))
 	if err != nil {
-		log.Println(err)
+		slog.Error("telegram: invalid proxy client", "error", err)
 
 		return nil
 	}
-	tr := &http.Transport{
-		Proxy:           http.ProxyURL(proxy),
-		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
-	}
-	return &Client{
-		Token:     token,
-		SecretKey: secretKey,
-		UserAgent: "Telegram/Golang",
-		BaseURL:   baseAPIMainURL + token,
-		HTTPClient: &http.Client{
-			Transport: tr,
-		},
-		Logger: log.New(os.Stderr, "Ghasedak-golang ", log.LstdFlags),
-	}
+	return c
 }
 
 const baseAPIMainURL = "https://api.telegram.org/bot"
 
-type doFunc func(req *http.Request) (*http.Response, error)
-
 func (c *Client) GetMe() *GetMeService {
 	return &GetMeService{c: c}
 }
 
 func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, err error) {
-	err = c.parseRequest(r, opts...)
+	for _, opt := range opts {
+		opt(r)
+	}
+	err = r.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	if c.LocalMode {
+		err = r.useLocalFiles()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	call := &APICall{
+		Method: r.apiMethod(),
+		Params: r.params,
+		Header: r.header,
+		Token:  c.Token,
+	}
+	if call.Header == nil {
+		call.Header = http.Header{}
+	}
+
+	ctx, span := c.startCallSpan(ctx, r)
+	data, err = c.chain(func(ctx context.Context, call *APICall) ([]byte, error) {
+		r.endpoint = "/" + call.Method
+		r.params = call.Params
+		r.header = call.Header
+		r.token = call.Token
+		return c.send(ctx, r)
+	})(ctx, call)
+	endSpan(span, err)
+	if err == nil {
+		noteCallbackAnswer(ctx, r)
+	}
+	return data, err
+}
+
+func (c *Client) send(ctx context.Context, r *request) (data []byte, err error) {
+	migrated := false
+	for attempt := 0; ; attempt++ {
+		if c.RateLimiter != nil && sendsMessage(r.apiMethod()) {
+			err = c.RateLimiter.Wait(ctx, r.chatID())
+			if err != nil {
+				return nil, err
+			}
+		}
+		data, err = c.doRequest(ctx, r)
+		if !migrated && c.migrateChat(ctx, r, err) {
+			migrated = true
+			continue
+		}
+		wait, retry := c.shouldRetry(ctx, r, err, attempt)
+		if !retry {
+			return data, err
+		}
+		c.logger().LogAttrs(ctx, slog.LevelInfo, "telegram: retrying call",
+			slog.String("method", r.apiMethod()),
+			slog.Duration("wait", wait),
+		)
+		if sleepContext(ctx, wait) != nil {
+			return nil, err
+		}
+	}
+}
+
+func (c *Client) doRequest(ctx context.Context, r *request) (data []byte, err error) {
+	err = c.parseRequest(r)
 	if err != nil {
 		return []byte{}, err
 	}
 	req, err := http.NewRequest(r.method, r.fullURL, r.body)
 
 	if err != nil {
-		return []byte{}, err
+		if body, ok := r.body.(io.Closer); ok {
+			body.Close()
+		}
+		return []byte{}, c.redactError(r, err)
 	}
 	req = req.WithContext(ctx)
 	req.Header = r.header
-	c.debug("request: %#v", req)
 
+	start := time.Now()
 	res, err := c.HTTPClient.Do(req)
 	if err != nil {
+		err = c.redactError(r, err)
+		c.observeCall(ctx, r, 0, time.Since(start), err)
 		return []byte{}, err
 	}
 	data, err = io.ReadAll(res.Body)
@@ -100,82 +196,78 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 		}
 	}()
 
-	c.debug("response: %#v", res)
-	c.debug("response body: %s", string(data))
-	c.debug("response status code: %d", res.StatusCode)
-
 	if res.StatusCode >= http.StatusBadRequest {
 		apiErr := new(common.APIError)
 		e := json.Unmarshal(data, apiErr)
-		if e != nil {
-			c.debug("failed to unmarshal json: %s", e)
+		if e != nil || apiErr.ErrorCode == 0 {
+			apiErr.ErrorCode = res.StatusCode
+			apiErr.Description = res.Status
 		}
+		c.observeCall(ctx, r, res.StatusCode, time.Since(start), apiErr)
 		return nil, apiErr
 	}
+	c.observeCall(ctx, r, res.StatusCode, time.Since(start), nil)
 	return data, nil
 }
 
-func (c *Client) debug(format string, v ...interface{}) {
-	if c.Debug {
-		c.Logger.Printf(format, v...)
-	}
-}
-
 func (r *request) validate() (err error) {
 	if r.query == nil {
 		r.query = url.Values{}
 	}
-	if r.form == nil {
-		r.form = url.Values{}
+	if r.params == nil {
+		r.params = params{}
 	}
 	return nil
 }
 
-func (r *request) setParam(key string, value interface{}) *request {
-	if r.query == nil {
-		r.query = url.Values{}
-	}
-
-	if reflect.TypeOf(value).Kind() == reflect.Slice {
-		v, err := json.Marshal(value)
-		if err == nil {
-			value = string(v)
-		}
-	}
-
-	r.query.Set(key, fmt.Sprintf("%v", value))
-	return r
-}
-
-func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
-	for _, opt := range opts {
-		opt(r)
-	}
+func (c *Client) parseRequest(r *request) (err error) {
 	err = r.validate()
 	if err != nil {
 		return err
 	}
 
-	fullURL := fmt.Sprintf("%s%s", c.BaseURL, r.endpoint)
-	queryString := r.query.Encode()
-	body := &bytes.Buffer{}
-	bodyString := r.form.Encode()
+	baseURL := c.BaseURL
+	if r.token != "" && c.Token != "" && r.token != c.Token {
+		baseURL = strings.Replace(baseURL, c.Token, r.token, 1)
+	}
+	fullURL := fmt.Sprintf("%s%s", baseURL, r.endpoint)
+	query := r.query
+	var body io.Reader = http.NoBody
 	header := http.Header{}
 	if r.header != nil {
 		header = r.header.Clone()
 	}
+	if c.UserAgent != "" {
+		header.Set("User-Agent", c.UserAgent)
+	}
 
-	if bodyString != "" {
+	switch {
+	case len(r.files) > 0:
+		multipartBody, contentType, err := r.multipartBody()
+		if err != nil {
+			return err
+		}
+		r.method = http.MethodPost
+		body = multipartBody
+		header.Set("Content-Type", contentType)
+	case r.method == http.MethodGet || c.UseGET:
+		r.method = http.MethodGet
+		for k, v := range r.values() {
+			query[k] = v
+		}
+	case len(r.params) > 0:
+		data, err := json.Marshal(r.params)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
 		header.Set("Content-Type", "application/json")
-		body = bytes.NewBufferString(string(r.json))
 	}
 
-	if queryString != "" {
+	if queryString := query.Encode(); queryString != "" {
 		fullURL = fmt.Sprintf("%s?%s", fullURL, queryString)
 	}
 
-	c.debug("full url: %s, body: %s", fullURL, bodyString)
-
 	r.fullURL = fullURL
 	r.header = header
 	r.body = body
@@ -199,6 +291,9 @@ func (c *Client) NewEditMessageTextService() *EditMessageTextService {
 func (c *Client) NewDeleteMessageService() *DeleteMessageService {
 	return &DeleteMessageService{c: c}
 }
+func (c *Client) NewGetUpdatesService() *GetUpdatesService {
+	return &GetUpdatesService{c: c}
+}
 func (c *Client) NewSetWebhookService() *SetWebhookService {
 	return &SetWebhookService{c: c}
 }
@@ -289,6 +384,9 @@ func (c *Client) NewGetFileService() *GetFileService {
 func (c *Client) NewGetUserProfilePhotosService() *GetUserProfilePhotosService {
 	return &GetUserProfilePhotosService{c: c}
 }
+func (c *Client) NewGetChatMemberService() *GetChatMemberService {
+	return &GetChatMemberService{c: c}
+}
 
 const (
 	PollTypeRegular PollType = "regular"
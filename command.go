@@ -0,0 +1,112 @@
+package telegram
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"unicode/utf16"
+)
+
+// Command is a bot command at the start of a message, such as
+// "/start@MyBot payload".
+type Command struct {
+	// Name is the command without the slash and bot username.
+	Name string
+	// Mention is the bot username the command was addressed to, if any.
+	Mention string
+	// Args is the text after the command, with surrounding spaces removed.
+	Args string
+	// StartPayload is the deep-link parameter of a /start command sent by
+	// opening t.me/<bot>?start=<payload>.
+	StartPayload string
+}
+
+// Fields splits Args around runs of white space.
+func (c *Command) Fields() []string {
+	return strings.Fields(c.Args)
+}
+
+// ParseCommand returns the command the text of message starts with.
+func ParseCommand(message *Message) (*Command, bool) {
+	if message == nil {
+		return nil, false
+	}
+	for _, entity := range message.Entities {
+		if entity.MessageEntityType != "bot_command" || entity.Offset != 0 {
+			continue
+		}
+		// Entity offsets and lengths count UTF-16 code units.
+		text := utf16.Encode([]rune(message.Text))
+		if entity.Length < 2 || entity.Length > int64(len(text)) {
+			return nil, false
+		}
+		command := string(utf16.Decode(text[1:entity.Length]))
+		args := string(utf16.Decode(text[entity.Length:]))
+
+		cmd := &Command{Args: strings.TrimSpace(args)}
+		cmd.Name, cmd.Mention, _ = strings.Cut(command, "@")
+		if cmd.Name == "start" {
+			cmd.StartPayload = cmd.Args
+		}
+		return cmd, true
+	}
+	return nil, false
+}
+
+type commandKey struct{}
+
+// CommandFromContext returns the command of the message passed to a handler
+// registered with Router.Command.
+func CommandFromContext(ctx context.Context) (*Command, bool) {
+	cmd, ok := ctx.Value(commandKey{}).(*Command)
+	return cmd, ok
+}
+
+// Command registers handler for messages starting with /name. Commands
+// addressed to another bot with /name@OtherBot are ignored; the bot's own
+// username is fetched with getMe the first time a command carries one.
+func (r *Router) Command(name string, handler HandlerFunc, filters ...Filter) *Router {
+	filters = append([]Filter{r.isCommand(name)}, filters...)
+	return r.Message(func(ctx *Context) error {
+		cmd, _ := ParseCommand(ctx.Update.Message)
+		return handler(ctx.withValue(commandKey{}, cmd))
+	}, filters...)
+}
+
+func (r *Router) isCommand(name string) Filter {
+	return func(ctx context.Context, update *Update) bool {
+		cmd, ok := ParseCommand(update.Message)
+		if !ok || !strings.EqualFold(cmd.Name, name) {
+			return false
+		}
+		if cmd.Mention == "" {
+			return true
+		}
+		username, err := r.botUsername(ctx)
+		if err != nil {
+			r.c.logger().Warn("telegram: getting bot username failed", "error", err)
+			return false
+		}
+		return strings.EqualFold(cmd.Mention, username)
+	}
+}
+
+type botUsername struct {
+	mu       sync.Mutex
+	username string
+}
+
+func (r *Router) botUsername(ctx context.Context) (string, error) {
+	r.bot.mu.Lock()
+	defer r.bot.mu.Unlock()
+
+	if r.bot.username != "" {
+		return r.bot.username, nil
+	}
+	me, err := r.c.NewGetMeService().Do(ctx)
+	if err != nil {
+		return "", err
+	}
+	r.bot.username = me.Username
+	return r.bot.username, nil
+}
@@ -1,18 +1,126 @@
 package common
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
 
 type APIError struct {
-	Ok          bool   `json:"ok"`
-	ErrorCode   int    `json:"error_code"`
-	Description string `json:"description"`
+	Ok          bool                `json:"ok"`
+	ErrorCode   int                 `json:"error_code"`
+	Description string              `json:"description"`
+	Parameters  *ResponseParameters `json:"parameters,omitempty"`
+}
+
+type ResponseParameters struct {
+	MigrateToChatID int64 `json:"migrate_to_chat_id"`
+	RetryAfter      int64 `json:"retry_after"`
 }
 
 func (e APIError) Error() string {
 	return fmt.Sprintf("<APIError>\ncode = %d \nmessage = %s \n", e.ErrorCode, e.Description)
 }
 
+func (e APIError) RetryAfter() int64 {
+	if e.Parameters == nil {
+		return 0
+	}
+	return e.Parameters.RetryAfter
+}
+
+func (e APIError) MigrateToChatID() int64 {
+	if e.Parameters == nil {
+		return 0
+	}
+	return e.Parameters.MigrateToChatID
+}
+
+// Is lets errors.Is match an APIError against the sentinel errors below,
+// both by HTTP status (ErrForbidden) and by description (ErrBotBlocked).
+func (e APIError) Is(target error) bool {
+	switch target {
+	case ErrBadRequest:
+		return e.ErrorCode == http.StatusBadRequest
+	case ErrUnauthorized:
+		return e.ErrorCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.ErrorCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.ErrorCode == http.StatusNotFound
+	case ErrConflict:
+		return e.ErrorCode == http.StatusConflict
+	case ErrTooManyRequests:
+		return e.ErrorCode == http.StatusTooManyRequests
+	case ErrChatMigrated:
+		return e.MigrateToChatID() != 0
+	}
+
+	description := strings.ToLower(e.Description)
+	for _, d := range descriptionErrors {
+		if target == d.err {
+			return e.ErrorCode == d.code && strings.Contains(description, d.text)
+		}
+	}
+	return false
+}
+
+var (
+	ErrBadRequest      = errors.New("bad request")
+	ErrUnauthorized    = errors.New("unauthorized")
+	ErrForbidden       = errors.New("forbidden")
+	ErrNotFound        = errors.New("not found")
+	ErrConflict        = errors.New("conflict")
+	ErrTooManyRequests = errors.New("too many requests")
+	ErrChatMigrated    = errors.New("group chat was upgraded to a supergroup chat")
+
+	ErrBotBlocked                  = errors.New("bot was blocked by the user")
+	ErrBotKicked                   = errors.New("bot was kicked from the chat")
+	ErrUserDeactivated             = errors.New("user is deactivated")
+	ErrCantInitiateConversation    = errors.New("bot can't initiate conversation with a user")
+	ErrChatNotFound                = errors.New("chat not found")
+	ErrUserNotFound                = errors.New("user not found")
+	ErrMessageNotModified          = errors.New("message is not modified")
+	ErrMessageToEditNotFound       = errors.New("message to edit not found")
+	ErrMessageToDeleteNotFound     = errors.New("message to delete not found")
+	ErrMessageToReplyNotFound      = errors.New("message to reply not found")
+	ErrMessageCantBeEdited         = errors.New("message can't be edited")
+	ErrMessageCantBeDeleted        = errors.New("message can't be deleted")
+	ErrNotEnoughRights             = errors.New("not enough rights")
+	ErrMessageTextEmpty            = errors.New("message text is empty")
+	ErrQueryTooOld                 = errors.New("query is too old")
+	ErrWrongFileIdentifier         = errors.New("wrong file identifier")
+	ErrWebhookActive               = errors.New("can't use getUpdates method while webhook is active")
+	ErrTerminatedByOtherGetUpdates = errors.New("terminated by other getUpdates request")
+)
+
+var descriptionErrors = []struct {
+	code int
+	text string
+	err  error
+}{
+	{http.StatusForbidden, "bot was blocked by the user", ErrBotBlocked},
+	{http.StatusForbidden, "bot was kicked", ErrBotKicked},
+	{http.StatusForbidden, "user is deactivated", ErrUserDeactivated},
+	{http.StatusForbidden, "bot can't initiate conversation", ErrCantInitiateConversation},
+	{http.StatusBadRequest, "chat not found", ErrChatNotFound},
+	{http.StatusBadRequest, "user not found", ErrUserNotFound},
+	{http.StatusBadRequest, "message is not modified", ErrMessageNotModified},
+	{http.StatusBadRequest, "message to edit not found", ErrMessageToEditNotFound},
+	{http.StatusBadRequest, "message to delete not found", ErrMessageToDeleteNotFound},
+	{http.StatusBadRequest, "message to be replied not found", ErrMessageToReplyNotFound},
+	{http.StatusBadRequest, "message can't be edited", ErrMessageCantBeEdited},
+	{http.StatusBadRequest, "message can't be deleted", ErrMessageCantBeDeleted},
+	{http.StatusBadRequest, "not enough rights", ErrNotEnoughRights},
+	{http.StatusBadRequest, "message text is empty", ErrMessageTextEmpty},
+	{http.StatusBadRequest, "query is too old", ErrQueryTooOld},
+	{http.StatusBadRequest, "wrong file identifier", ErrWrongFileIdentifier},
+	{http.StatusConflict, "webhook is active", ErrWebhookActive},
+	{http.StatusConflict, "terminated by other getupdates request", ErrTerminatedByOtherGetUpdates},
+}
+
 func IsAPIError(e error) bool {
-	_, ok := e.(*APIError)
-	return ok
+	apiErr := new(APIError)
+	return errors.As(e, &apiErr)
 }
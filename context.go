@@ -0,0 +1,166 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"strconv"
+)
+
+var (
+	ErrNoChat          = errors.New("update has no chat")
+	ErrNoMessage       = errors.New("update has no message")
+	ErrNoCallbackQuery = errors.New("update is not a callback query")
+)
+
+// Context is passed to Router handlers. It carries the update being handled
+// and the client, and embeds the context.Context the update was received
+// with so it can be passed to any call. Its helpers infer the chat, message,
+// forum topic and inline message from the update.
+type Context struct {
+	context.Context
+	Update *Update
+	Client *Client
+}
+
+func (c *Client) newContext(ctx context.Context, update *Update) *Context {
+	return &Context{Context: ctx, Update: update, Client: c}
+}
+
+// withValue returns a copy of ctx whose embedded context carries key and
+// value.
+func (ctx *Context) withValue(key, value any) *Context {
+	next := *ctx
+	next.Context = context.WithValue(ctx.Context, key, value)
+	return &next
+}
+
+// Message returns the message the update is about; see Update.EffectiveMessage.
+func (ctx *Context) Message() *Message {
+	return ctx.Update.EffectiveMessage()
+}
+
+func (ctx *Context) ChatID() int64 {
+	return ctx.Update.EffectiveChatID()
+}
+
+func (ctx *Context) Sender() *User {
+	return ctx.Update.EffectiveUser()
+}
+
+// Command returns the command of a message handled by Router.Command.
+func (ctx *Context) Command() (*Command, bool) {
+	return CommandFromContext(ctx)
+}
+
+// Reply sends text to the chat of the update, in the same forum topic. Replies
+// to incoming messages quote them; replies to callback queries do not quote
+// the bot's own message.
+func (ctx *Context) Reply(text string) (*Message, error) {
+	chatID := ctx.ChatID()
+	if chatID == 0 {
+		return nil, ErrNoChat
+	}
+	s := ctx.Client.NewSendMessageService().ChatID(chatID).Text(text)
+	if threadID := ctx.threadID(); threadID != 0 {
+		s.MessageThreadID(threadID)
+	}
+	if messageID := ctx.incomingMessageID(); messageID != 0 {
+		s.ReplyToMessageID(messageID).AllowSendingWithoutReply(true)
+	}
+	return s.Do(ctx)
+}
+
+// ReplyPhoto sends a photo the same way Reply sends text.
+func (ctx *Context) ReplyPhoto(photo InputFile, caption string) (*Message, error) {
+	chatID := ctx.ChatID()
+	if chatID == 0 {
+		return nil, ErrNoChat
+	}
+	s := ctx.Client.NewSendPhotoService().ChatID(chatID).Photo(photo)
+	if caption != "" {
+		s.Caption(caption)
+	}
+	if threadID := ctx.threadID(); threadID != 0 {
+		s.MessageThreadID(threadID)
+	}
+	if messageID := ctx.incomingMessageID(); messageID != 0 {
+		s.ReplyToMessageID(messageID).AllowSendingWithoutReply(true)
+	}
+	return s.Do(ctx)
+}
+
+// Edit replaces the text of the message the update is about, typically the
+// bot's message whose button was pressed. Messages sent in inline mode are
+// edited through their inline message ID, and nil is returned for them.
+func (ctx *Context) Edit(text string) (*Message, error) {
+	s := ctx.Client.NewEditMessageTextService().Text(text)
+	if query := ctx.Update.CallbackQuery; query != nil && query.InlineMessageID != "" {
+		return s.InlineMessageID(query.InlineMessageID).Do(ctx)
+	}
+	message := ctx.Message()
+	if message == nil {
+		return nil, ErrNoMessage
+	}
+	return s.ChatID(message.Chat.ID).MessageID(message.MessageID).Do(ctx)
+}
+
+// Answer answers the callback query being handled, showing text as a
+// notification or, with showAlert, as an alert.
+func (ctx *Context) Answer(text string, showAlert bool) error {
+	query := ctx.Update.CallbackQuery
+	if query == nil {
+		return ErrNoCallbackQuery
+	}
+	s := ctx.Client.NewAnswerCallbackQueryService().CallbackQueryID(query.ID)
+	if text != "" {
+		s.Text(text).ShowAlert(showAlert)
+	}
+	_, err := s.Do(ctx)
+	return err
+}
+
+// Delete deletes the message the update is about.
+func (ctx *Context) Delete() error {
+	message := ctx.Message()
+	if message == nil {
+		return ErrNoMessage
+	}
+	_, err := ctx.Client.NewDeleteMessageService().
+		ChatID(message.Chat.ID).
+		MessageID(message.MessageID).
+		Do(ctx)
+	return err
+}
+
+// Forward forwards the message the update is about to another chat.
+func (ctx *Context) Forward(toChatID int64) (*Message, error) {
+	message := ctx.Message()
+	if message == nil {
+		return nil, ErrNoMessage
+	}
+	return ctx.Client.NewForwardMessageService().
+		ChatID(toChatID).
+		FromChatID(strconv.FormatInt(message.Chat.ID, 10)).
+		MessageID(message.MessageID).
+		Do(ctx)
+}
+
+func (ctx *Context) threadID() int64 {
+	message := ctx.Message()
+	if message == nil || !message.IsTopicMessage {
+		return 0
+	}
+	return message.MessageThreadID
+}
+
+// incomingMessageID returns the ID of the message the update delivered, as
+// opposed to the bot's message a callback query refers to.
+func (ctx *Context) incomingMessageID() int64 {
+	if ctx.Update.CallbackQuery != nil {
+		return 0
+	}
+	if message := ctx.Message(); message != nil {
+		return message.MessageID
+	}
+	return 0
+}
@@ -0,0 +1,237 @@
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Conversation is a multi-step dialog with each user, driven by named
+// states. A user enters it through an entry point; afterwards their updates
+// go to the handlers of their current state until a handler ends the
+// conversation, the user sends a cancel command or the timeout passes.
+// Handlers move between states with Session.Transition.
+//
+// Conversations are keyed by chat and user, and are registered with
+// Router.Conversation, which consults them before its own routes. Updates of
+// one user must be handled in order, as a Poller does.
+type Conversation struct {
+	name      string
+	storage   ConversationStorage
+	router    *Router
+	entries   []conversationRoute
+	states    map[string][]conversationRoute
+	cancel    []string
+	onCancel  HandlerFunc
+	timeout   time.Duration
+	onTimeout HandlerFunc
+	fallback  HandlerFunc
+}
+
+type conversationRoute struct {
+	filters []Filter
+	handler HandlerFunc
+}
+
+// NewConversation returns a conversation that keeps its states in storage.
+// name tells conversations sharing a storage apart.
+func NewConversation(name string, storage ConversationStorage) *Conversation {
+	return &Conversation{
+		name:    name,
+		storage: storage,
+		states:  map[string][]conversationRoute{},
+	}
+}
+
+// Entry registers an entry point for users outside the conversation. Its
+// handler usually transitions to the first state; if it does not, the
+// conversation ends right away.
+func (c *Conversation) Entry(handler HandlerFunc, filters ...Filter) *Conversation {
+	c.entries = append(c.entries, conversationRoute{filters: filters, handler: handler})
+	return c
+}
+
+// EntryCommand registers /name as an entry point.
+func (c *Conversation) EntryCommand(name string, handler HandlerFunc, filters ...Filter) *Conversation {
+	return c.Entry(handler, append([]Filter{c.isCommand(name)}, filters...)...)
+}
+
+// State registers a handler for updates of users in the named state. A state
+// may have several handlers; the first whose filters match is used.
+func (c *Conversation) State(name string, handler HandlerFunc, filters ...Filter) *Conversation {
+	c.states[name] = append(c.states[name], conversationRoute{filters: filters, handler: handler})
+	return c
+}
+
+// Cancel ends the conversation when a user in it sends one of the commands,
+// calling handler first if it is not nil.
+func (c *Conversation) Cancel(handler HandlerFunc, commands ...string) *Conversation {
+	c.cancel = append(c.cancel, commands...)
+	c.onCancel = handler
+	return c
+}
+
+// Timeout ends the conversation of users who sent nothing for d. handler, if
+// not nil, is called with the first update that arrives after the timeout,
+// which is then handled as if the user had not been in the conversation.
+// Timeout does not delete states of users who never return; use
+// MemoryStorage.Expire, or expiry in your own storage, for that.
+func (c *Conversation) Timeout(d time.Duration, handler HandlerFunc) *Conversation {
+	c.timeout = d
+	c.onTimeout = handler
+	return c
+}
+
+// Fallback handles updates of users in the conversation that match no
+// handler of their state. Without it such updates go on to the router.
+func (c *Conversation) Fallback(handler HandlerFunc) *Conversation {
+	c.fallback = handler
+	return c
+}
+
+// Conversation registers conv; conversations are consulted in the order
+// they were registered, before the router's own routes.
+func (r *Router) Conversation(conv *Conversation) *Router {
+	conv.router = r
+	r.conversations = append(r.conversations, conv)
+	return r
+}
+
+// handle runs the conversation for ctx and reports whether it consumed the
+// update.
+func (c *Conversation) handle(ctx *Context) (bool, error) {
+	key, ok := c.key(ctx.Update)
+	if !ok {
+		return false, nil
+	}
+	state, err := c.storage.Get(ctx, key)
+	if err != nil {
+		return true, fmt.Errorf("conversation %s: %w", c.name, err)
+	}
+
+	if state != nil && c.timeout > 0 && time.Since(state.UpdatedAt) > c.timeout {
+		err = c.storage.Delete(ctx, key)
+		if err != nil {
+			return true, fmt.Errorf("conversation %s: %w", c.name, err)
+		}
+		state = nil
+		if c.onTimeout != nil {
+			err = c.onTimeout(ctx)
+			if err != nil {
+				return true, err
+			}
+		}
+	}
+
+	if state == nil {
+		route, ok := matchConversationRoute(ctx, c.entries)
+		if !ok {
+			return false, nil
+		}
+		return true, c.run(ctx, key, &ConversationState{}, route.handler)
+	}
+
+	for _, command := range c.cancel {
+		if c.isCommand(command)(ctx, ctx.Update) {
+			err = c.storage.Delete(ctx, key)
+			if err != nil {
+				return true, fmt.Errorf("conversation %s: %w", c.name, err)
+			}
+			if c.onCancel != nil {
+				return true, c.onCancel(ctx)
+			}
+			return true, nil
+		}
+	}
+
+	route, ok := matchConversationRoute(ctx, c.states[state.State])
+	switch {
+	case ok:
+		return true, c.run(ctx, key, state, route.handler)
+	case c.fallback != nil:
+		return true, c.run(ctx, key, state, c.fallback)
+	}
+	return false, nil
+}
+
+// run calls handler with the session of key and saves the session if the
+// handler succeeds.
+func (c *Conversation) run(ctx *Context, key ConversationKey, state *ConversationState, handler HandlerFunc) error {
+	if state.Data == nil {
+		state.Data = map[string]string{}
+	}
+	session := &Session{Key: key, State: state.State, Data: state.Data}
+	err := handler(ctx.withValue(sessionKey{}, session))
+	if err != nil {
+		return err
+	}
+
+	if session.ended || session.State == "" {
+		err = c.storage.Delete(ctx, key)
+	} else if _, ok := c.states[session.State]; !ok {
+		err = fmt.Errorf("conversation %s has no state %q", c.name, session.State)
+	} else {
+		err = c.storage.Set(ctx, key, &ConversationState{
+			State:     session.State,
+			Data:      session.Data,
+			UpdatedAt: time.Now(),
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("conversation %s: %w", c.name, err)
+	}
+	return nil
+}
+
+func (c *Conversation) key(update *Update) (ConversationKey, bool) {
+	user := update.EffectiveUser()
+	chatID := update.EffectiveChatID()
+	if user == nil || user.ID == 0 || chatID == 0 {
+		return ConversationKey{}, false
+	}
+	return ConversationKey{Conversation: c.name, ChatID: chatID, UserID: user.ID}, true
+}
+
+func (c *Conversation) isCommand(name string) Filter {
+	return func(ctx context.Context, update *Update) bool {
+		return c.router.isCommand(name)(ctx, update)
+	}
+}
+
+func matchConversationRoute(ctx *Context, routes []conversationRoute) (conversationRoute, bool) {
+	for _, route := range routes {
+		if matchAll(ctx, ctx.Update, route.filters) {
+			return route, true
+		}
+	}
+	return conversationRoute{}, false
+}
+
+// Session is the state of the conversation a handler runs in. Changes are
+// saved when the handler returns without an error.
+type Session struct {
+	Key   ConversationKey
+	State string
+	Data  map[string]string
+	ended bool
+}
+
+// Transition moves the user to the named state once the handler returns.
+func (s *Session) Transition(state string) {
+	s.State = state
+	s.ended = false
+}
+
+// End ends the conversation and discards its data once the handler returns.
+func (s *Session) End() {
+	s.ended = true
+}
+
+type sessionKey struct{}
+
+// Session returns the conversation session of the handler, or nil outside a
+// Conversation.
+func (ctx *Context) Session() *Session {
+	session, _ := ctx.Value(sessionKey{}).(*Session)
+	return session
+}
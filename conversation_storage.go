@@ -0,0 +1,102 @@
+package telegram
+
+import (
+	"context"
+	"maps"
+	"sync"
+	"time"
+)
+
+// ConversationKey identifies the conversation of one user in one chat.
+type ConversationKey struct {
+	Conversation string
+	ChatID       int64
+	UserID       int64
+}
+
+// ConversationState is what a ConversationStorage keeps per key.
+type ConversationState struct {
+	State     string            `json:"state"`
+	Data      map[string]string `json:"data,omitempty"`
+	UpdatedAt time.Time         `json:"updated_at"`
+}
+
+// ConversationStorage persists conversation states, e.g. in Redis or a
+// database so dialogs survive restarts. Get returns nil without an error
+// when the key has no state.
+type ConversationStorage interface {
+	Get(ctx context.Context, key ConversationKey) (*ConversationState, error)
+	Set(ctx context.Context, key ConversationKey, state *ConversationState) error
+	Delete(ctx context.Context, key ConversationKey) error
+}
+
+// MemoryStorage keeps conversation states in memory, so they are lost when
+// the process exits. States of users who never come back stay until they
+// expire; see Expire.
+type MemoryStorage struct {
+	mu        sync.Mutex
+	states    map[ConversationKey]ConversationState
+	expiry    time.Duration
+	lastSweep time.Time
+}
+
+func NewMemoryStorage() *MemoryStorage {
+	return &MemoryStorage{states: map[ConversationKey]ConversationState{}}
+}
+
+// Expire drops states that were not updated for d, so abandoned
+// conversations do not accumulate. d should not be shorter than the timeout
+// of the conversations using the storage, or their timeout handlers will not
+// run. States never expire when d is zero, the default.
+func (s *MemoryStorage) Expire(d time.Duration) *MemoryStorage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.expiry = d
+	return s
+}
+
+func (s *MemoryStorage) Get(ctx context.Context, key ConversationKey) (*ConversationState, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep(time.Now())
+	state, ok := s.states[key]
+	if !ok {
+		return nil, nil
+	}
+	state.Data = maps.Clone(state.Data)
+	return &state, nil
+}
+
+func (s *MemoryStorage) Set(ctx context.Context, key ConversationKey, state *ConversationState) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep(time.Now())
+	stored := *state
+	stored.Data = maps.Clone(state.Data)
+	s.states[key] = stored
+	return nil
+}
+
+func (s *MemoryStorage) Delete(ctx context.Context, key ConversationKey) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.states, key)
+	return nil
+}
+
+// sweep deletes expired states, at most once a minute.
+func (s *MemoryStorage) sweep(now time.Time) {
+	if s.expiry <= 0 || now.Sub(s.lastSweep) < time.Minute {
+		return
+	}
+	s.lastSweep = now
+	for key, state := range s.states {
+		if now.Sub(state.UpdatedAt) > s.expiry {
+			delete(s.states, key)
+		}
+	}
+}
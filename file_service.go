@@ -2,7 +2,11 @@ package telegram
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"net/http"
+	"path/filepath"
+	"strings"
 	"telegram/common"
 )
 
@@ -11,14 +15,19 @@ type GetFileService struct {
 	fileID *string
 }
 
-func (t *GetFileService) FleID(fileID string) *GetFileService {
+func (t *GetFileService) FileID(fileID string) *GetFileService {
 	t.fileID = &fileID
 	return t
 }
 
-func (t *GetFileService) Do(ctx context.Context, opts ...RequestOption) (res []*GetFile, err error) {
+// Deprecated: use FileID.
+func (t *GetFileService) FleID(fileID string) *GetFileService {
+	return t.FileID(fileID)
+}
+
+func (t *GetFileService) Do(ctx context.Context, opts ...RequestOption) (res *File, err error) {
 	r := &request{
-		method:   http.MethodGet,
+		method:   http.MethodPost,
 		endpoint: "/getFile",
 	}
 
@@ -28,20 +37,48 @@ func (t *GetFileService) Do(ctx context.Context, opts ...RequestOption) (res []*
 	if err != nil {
 		return nil, err
 	}
-	data = common.ToJSONList(data)
+	return decodeResult[*File](data)
+}
+
+// DownloadFile resolves the path of fileID with getFile and streams the file
+// contents into w.
+func (c *Client) DownloadFile(ctx context.Context, fileID string, w io.Writer) error {
+	res, err := c.NewGetFileService().FileID(fileID).Do(ctx)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	if res == nil || res.FilePath == "" {
+		return fmt.Errorf("file %s has no file_path", fileID)
 	}
 
-	res = make([]*GetFile, 0)
-	err = json.Unmarshal(data, &res)
+	filePath := res.FilePath
+	if c.LocalMode && filepath.IsAbs(filePath) {
+		return copyLocalFile(filePath, w)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.fileURL(filePath), nil)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return c.redactError(nil, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return &common.APIError{ErrorCode: resp.StatusCode, Description: resp.Status}
 	}
-	return res, nil
+	_, err = io.Copy(w, resp.Body)
+	return err
 }
 
-type GetFile struct {
-	Ok     bool    `json:"ok"`
-	Result Message `json:"result"`
+// fileURL builds the download URL from FileURL, or from BaseURL so a client
+// pointed at a self-hosted Bot API server downloads from that server as well.
+func (c *Client) fileURL(filePath string) string {
+	if c.FileURL != "" {
+		return strings.TrimSuffix(c.FileURL, "/") + "/" + filePath
+	}
+	server := strings.TrimSuffix(c.BaseURL, "/bot"+c.Token)
+	return fmt.Sprintf("%s/file/bot%s/%s", server, c.Token, filePath)
 }
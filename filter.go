@@ -0,0 +1,130 @@
+package telegram
+
+import (
+	"context"
+	"regexp"
+	"slices"
+)
+
+const (
+	ChatTypePrivate    = "private"
+	ChatTypeGroup      = "group"
+	ChatTypeSupergroup = "supergroup"
+	ChatTypeChannel    = "channel"
+)
+
+// Filter decides whether a route handles an update.
+type Filter func(ctx context.Context, update *Update) bool
+
+func matchAll(ctx context.Context, update *Update, filters []Filter) bool {
+	for _, filter := range filters {
+		if !filter(ctx, update) {
+			return false
+		}
+	}
+	return true
+}
+
+// And matches updates that match all filters.
+func And(filters ...Filter) Filter {
+	return func(ctx context.Context, update *Update) bool {
+		return matchAll(ctx, update, filters)
+	}
+}
+
+// Or matches updates that match any of filters.
+func Or(filters ...Filter) Filter {
+	return func(ctx context.Context, update *Update) bool {
+		for _, filter := range filters {
+			if filter(ctx, update) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func Not(filter Filter) Filter {
+	return func(ctx context.Context, update *Update) bool {
+		return !filter(ctx, update)
+	}
+}
+
+// ChatType matches updates from chats of the given types, such as
+// ChatTypePrivate.
+func ChatType(chatTypes ...string) Filter {
+	return func(ctx context.Context, update *Update) bool {
+		return slices.Contains(chatTypes, effectiveChatType(update))
+	}
+}
+
+// TextMatches matches messages whose text or caption matches re.
+func TextMatches(re *regexp.Regexp) Filter {
+	return func(ctx context.Context, update *Update) bool {
+		message := update.EffectiveMessage()
+		if message == nil {
+			return false
+		}
+		return re.MatchString(message.Text) || re.MatchString(message.Caption)
+	}
+}
+
+func HasPhoto() Filter {
+	return func(ctx context.Context, update *Update) bool {
+		message := update.EffectiveMessage()
+		return message != nil && len(message.Photo) > 0
+	}
+}
+
+// FromUsers matches updates sent by one of the given users.
+func FromUsers(userIDs ...int64) Filter {
+	return func(ctx context.Context, update *Update) bool {
+		user := update.EffectiveUser()
+		return user != nil && slices.Contains(userIDs, user.ID)
+	}
+}
+
+// FromAdmin matches updates sent in groups by an administrator of the group,
+// including anonymous administrators. It looks the sender up with
+// getChatMember for every update, and does not match when that fails.
+func FromAdmin(c *Client) Filter {
+	return func(ctx context.Context, update *Update) bool {
+		chatID := update.EffectiveChatID()
+		switch effectiveChatType(update) {
+		case ChatTypeGroup, ChatTypeSupergroup:
+		default:
+			return false
+		}
+		if message := update.EffectiveMessage(); message != nil && message.SenderChat.ID == chatID {
+			return true
+		}
+		user := update.EffectiveUser()
+		if user == nil || user.ID == 0 {
+			return false
+		}
+
+		member, err := c.NewGetChatMemberService().ChatID(chatID).UserID(user.ID).Do(ctx)
+		if err != nil {
+			c.logger().Warn("telegram: checking chat administrator failed", "chat_id", chatID, "error", err)
+			return false
+		}
+		return member.IsAdmin()
+	}
+}
+
+func effectiveChatType(update *Update) string {
+	if message := update.EffectiveMessage(); message != nil {
+		return message.Chat.ChatType
+	}
+	switch {
+	case update.MyChatMember != nil:
+		return update.MyChatMember.Chat.ChatType
+	case update.ChatMember != nil:
+		return update.ChatMember.Chat.ChatType
+	case update.ChatJoinRequest != nil:
+		return update.ChatJoinRequest.Chat.ChatType
+	case update.InlineQuery != nil:
+		return update.InlineQuery.ChatType
+	}
+	return ""
+}
@@ -0,0 +1,60 @@
+package telegram
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// InputFile is a file uploaded with multipart/form-data. Exactly one of
+// Path, Reader or Bytes should be set; Name is the filename reported to
+// Telegram and defaults to the base name of Path.
+type InputFile struct {
+	Name   string
+	Path   string
+	Reader io.Reader
+	Bytes  []byte
+}
+
+func FilePath(path string) InputFile {
+	return InputFile{Path: path}
+}
+
+func FileReader(name string, reader io.Reader) InputFile {
+	return InputFile{Name: name, Reader: reader}
+}
+
+func FileBytes(name string, data []byte) InputFile {
+	return InputFile{Name: name, Bytes: data}
+}
+
+func (f InputFile) isSet() bool {
+	return f.Path != "" || f.Reader != nil || f.Bytes != nil
+}
+
+func (f InputFile) fileName() string {
+	if f.Name != "" {
+		return f.Name
+	}
+	if f.Path != "" {
+		return filepath.Base(f.Path)
+	}
+	return "file"
+}
+
+func (f InputFile) open() (io.ReadCloser, error) {
+	switch {
+	case f.Path != "":
+		return os.Open(f.Path)
+	case f.Reader != nil:
+		if rc, ok := f.Reader.(io.ReadCloser); ok {
+			return rc, nil
+		}
+		return io.NopCloser(f.Reader), nil
+	case f.Bytes != nil:
+		return io.NopCloser(bytes.NewReader(f.Bytes)), nil
+	}
+	return nil, errors.New("input file has no content")
+}
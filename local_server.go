@@ -0,0 +1,107 @@
+package telegram
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// useLocalFiles replaces files given by path with file:// URIs, which a
+// local server reads from its own disk without the upload size limit. Files
+// given as readers or bytes are still uploaded.
+func (r *request) useLocalFiles() error {
+	files := r.files[:0]
+	for _, f := range r.files {
+		if f.file.Path == "" {
+			files = append(files, f)
+			continue
+		}
+		path, err := filepath.Abs(f.file.Path)
+		if err != nil {
+			return err
+		}
+		uri := "file://" + filepath.ToSlash(path)
+		if !r.replaceAttachment(f.field, uri) {
+			r.setParam(f.field, uri)
+		}
+	}
+	r.files = files
+	return nil
+}
+
+// replaceAttachment points media group items that reference the multipart
+// part field with attach:// at uri instead.
+func (r *request) replaceAttachment(field, uri string) bool {
+	items, ok := r.params["media"].([]params)
+	if !ok {
+		return false
+	}
+	replaced := false
+	for _, item := range items {
+		for _, key := range []string{"media", "thumbnail"} {
+			if item[key] == "attach://"+field {
+				item[key] = uri
+				replaced = true
+			}
+		}
+	}
+	return replaced
+}
+
+func copyLocalFile(path string, w io.Writer) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(w, f)
+	return err
+}
+
+// MigrateToLocalServer logs the bot out of its current server and switches
+// the client to the local server at serverURL. Telegram requires the log out
+// before a bot is served locally, and the bot cannot log back in to the cloud
+// server for 10 minutes afterwards. When the client already uses a local
+// server, the bot is moved with close instead, which requires the webhook to
+// be deleted first.
+//
+// The client must not be used concurrently while it is migrated.
+func (c *Client) MigrateToLocalServer(ctx context.Context, serverURL string) error {
+	err := checkServerURL(serverURL)
+	if err != nil {
+		return err
+	}
+	if c.LocalMode {
+		_, err = c.NewCloseService().Do(ctx)
+	} else {
+		_, err = c.NewLogOutService().Do(ctx)
+	}
+	if err != nil {
+		return err
+	}
+
+	err = c.setServer(serverURL)
+	if err != nil {
+		return err
+	}
+	c.LocalMode = true
+	return nil
+}
+
+// MigrateToCloud logs the bot out of the local server and switches the
+// client back to api.telegram.org.
+//
+// The client must not be used concurrently while it is migrated.
+func (c *Client) MigrateToCloud(ctx context.Context) error {
+	_, err := c.NewLogOutService().Do(ctx)
+	if err != nil {
+		return err
+	}
+
+	c.BaseURL = baseAPIMainURL + c.Token
+	c.FileURL = ""
+	c.LocalMode = false
+	return nil
+}
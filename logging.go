@@ -0,0 +1,124 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/url"
+	"sort"
+	"strings"
+	"telegram/common"
+	"time"
+)
+
+const redacted = "[REDACTED]"
+
+// sensitiveParams are parameters that carry message contents or secrets and
+// are never written to logs.
+var sensitiveParams = map[string]bool{
+	"text":              true,
+	"caption":           true,
+	"secret_token":      true,
+	"phone_number":      true,
+	"first_name":        true,
+	"last_name":         true,
+	"vcard":             true,
+	"question":          true,
+	"options":           true,
+	"explanation":       true,
+	"description":       true,
+	"short_description": true,
+	"name":              true,
+	"url":               true,
+	"certificate":       true,
+	"media":             true,
+	"reply_markup":      true,
+}
+
+var discardLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+func (c *Client) logger() *slog.Logger {
+	if c.Logger == nil {
+		return discardLogger
+	}
+	return c.Logger
+}
+
+func (c *Client) logCall(ctx context.Context, r *request, status int, latency time.Duration, err error) {
+	logger := c.logger()
+	if !logger.Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", r.apiMethod()),
+		slog.Int("status", status),
+		slog.Duration("latency", latency),
+	}
+	if chatID := r.chatID(); chatID != "" {
+		attrs = append(attrs, slog.String("chat_id", chatID))
+	}
+	attrs = append(attrs, slog.Any("params", logParams(r.params)))
+
+	apiErr := new(common.APIError)
+	switch {
+	case errors.As(err, &apiErr):
+		attrs = append(attrs,
+			slog.Int("error_code", apiErr.ErrorCode),
+			slog.String("error", apiErr.Description),
+		)
+	case err != nil:
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+
+	logger.LogAttrs(ctx, slog.LevelDebug, "telegram: api call", attrs...)
+}
+
+// logParams renders call parameters for logs, hiding the values of
+// sensitiveParams.
+type logParams params
+
+func (p logParams) LogValue() slog.Value {
+	keys := make([]string, 0, len(p))
+	for k := range p {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	attrs := make([]slog.Attr, 0, len(keys))
+	for _, k := range keys {
+		if sensitiveParams[k] {
+			attrs = append(attrs, slog.String(k, redacted))
+			continue
+		}
+		attrs = append(attrs, slog.String(k, formatParam(p[k])))
+	}
+	return slog.GroupValue(attrs...)
+}
+
+// redactError removes the bot token from errors returned by the HTTP client,
+// which quote the request URL. r, when not nil, is the request that failed;
+// its token differs from the client's when a middleware rotated it.
+func (c *Client) redactError(r *request, err error) error {
+	urlErr := new(url.Error)
+	if !errors.As(err, &urlErr) {
+		return err
+	}
+	urlErr.URL = c.redact(urlErr.URL)
+	if r != nil {
+		urlErr.URL = redactToken(urlErr.URL, r.token)
+	}
+	return err
+}
+
+func (c *Client) redact(s string) string {
+	return redactToken(s, c.Token)
+}
+
+func redactToken(s, token string) string {
+	if token == "" {
+		return s
+	}
+	return strings.ReplaceAll(s, token, redacted)
+}
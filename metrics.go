@@ -0,0 +1,74 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"telegram/common"
+	"time"
+)
+
+// Metrics receives measurements from the client. Its methods are called
+// from the goroutines making calls and handling updates, so they must be
+// safe for concurrent use and should not block. The metrics package provides
+// an implementation that exposes them to Prometheus.
+type Metrics interface {
+	// ObserveCall is called for every HTTP request sent to the Bot API,
+	// including retries. code is the HTTP status or the error_code reported
+	// by Telegram, and 0 when no response was received.
+	ObserveCall(method string, code int, latency time.Duration)
+	// ObserveUpdate is called after a handler passed to a Poller or
+	// WebhookHandler returns. lag is the time between the date of the update
+	// and the start of its handling, which is only known to the second and is
+	// zero for updates without a date.
+	ObserveUpdate(updateType string, lag, duration time.Duration, err error)
+}
+
+func (c *Client) observeCall(ctx context.Context, r *request, status int, latency time.Duration, err error) {
+	c.logCall(ctx, r, status, latency, err)
+	if c.Metrics == nil {
+		return
+	}
+
+	code := status
+	if apiErr := new(common.APIError); errors.As(err, &apiErr) {
+		code = apiErr.ErrorCode
+	}
+	c.Metrics.ObserveCall(r.apiMethod(), code, latency)
+}
+
+// handleUpdate runs handler inside a span and reports the update to
+// Client.Metrics.
+func (c *Client) handleUpdate(ctx context.Context, handler UpdateHandler, update *Update) error {
+	ctx, span := c.startUpdateSpan(ctx, update)
+	start := time.Now()
+	err := handler.HandleUpdate(ctx, update)
+	endSpan(span, err)
+	if c.Metrics != nil {
+		c.Metrics.ObserveUpdate(update.Type(), updateLag(update, start), time.Since(start), err)
+	}
+	return err
+}
+
+func updateLag(update *Update, start time.Time) time.Duration {
+	var date int64
+	switch {
+	case update.Message != nil:
+		date = update.Message.Date
+	case update.EditedMessage != nil:
+		date = update.EditedMessage.EditDate
+	case update.ChannelPost != nil:
+		date = update.ChannelPost.Date
+	case update.EditedChannelPost != nil:
+		date = update.EditedChannelPost.EditDate
+	case update.MyChatMember != nil:
+		date = update.MyChatMember.Date
+	case update.ChatMember != nil:
+		date = update.ChatMember.Date
+	case update.ChatJoinRequest != nil:
+		date = update.ChatJoinRequest.Date
+	}
+	if date == 0 {
+		return 0
+	}
+	return max(start.Sub(time.Unix(date, 0)), 0)
+}
@@ -0,0 +1,212 @@
+// Package metrics exposes the measurements reported through
+// telegram.Metrics in the Prometheus text exposition format, without
+// depending on the Prometheus client library.
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"telegram"
+	"time"
+)
+
+var _ telegram.Metrics = (*Prometheus)(nil)
+
+// DefaultBuckets are the upper bounds, in seconds, of the latency
+// histograms. They reach a minute so long-polling getUpdates calls fit.
+var DefaultBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60}
+
+// Prometheus collects call and update metrics and serves them on its
+// ServeHTTP method, which can be mounted at /metrics for scraping:
+//
+//	telegram_api_requests_total{method,code}
+//	telegram_api_request_duration_seconds{method}
+//	telegram_updates_total{type,result}
+//	telegram_update_handling_duration_seconds{type}
+//	telegram_update_lag_seconds{type}
+//
+// code is the HTTP status or Telegram error_code of the call, so flood
+// control shows up as code="429", and "0" when no response was received.
+type Prometheus struct {
+	namespace string
+	buckets   []float64
+
+	mu             sync.Mutex
+	requests       map[[2]string]uint64
+	requestLatency map[string]*histogram
+	updates        map[[2]string]uint64
+	updateDuration map[string]*histogram
+	updateLag      map[string]*histogram
+}
+
+// NewPrometheus returns a collector whose metric names start with namespace,
+// "telegram" when it is empty.
+func NewPrometheus(namespace string) *Prometheus {
+	if namespace == "" {
+		namespace = "telegram"
+	}
+	return &Prometheus{
+		namespace:      namespace,
+		buckets:        DefaultBuckets,
+		requests:       map[[2]string]uint64{},
+		requestLatency: map[string]*histogram{},
+		updates:        map[[2]string]uint64{},
+		updateDuration: map[string]*histogram{},
+		updateLag:      map[string]*histogram{},
+	}
+}
+
+func (p *Prometheus) ObserveCall(method string, code int, latency time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.requests[[2]string{method, strconv.Itoa(code)}]++
+	p.histogram(p.requestLatency, method).observe(latency)
+}
+
+func (p *Prometheus) ObserveUpdate(updateType string, lag, duration time.Duration, err error) {
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.updates[[2]string{updateType, result}]++
+	p.histogram(p.updateDuration, updateType).observe(duration)
+	if lag > 0 {
+		p.histogram(p.updateLag, updateType).observe(lag)
+	}
+}
+
+func (p *Prometheus) histogram(m map[string]*histogram, key string) *histogram {
+	h, ok := m[key]
+	if !ok {
+		h = &histogram{bounds: p.buckets, counts: make([]uint64, len(p.buckets))}
+		m[key] = h
+	}
+	return h
+}
+
+func (p *Prometheus) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_, _ = p.WriteTo(w)
+}
+
+// WriteTo writes the current values of all metrics in the text exposition
+// format.
+func (p *Prometheus) WriteTo(w io.Writer) (int64, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	cw := &countingWriter{w: bufio.NewWriter(w)}
+	p.writeCounter(cw, "api_requests_total", "Requests sent to the Bot API.", "method", "code", p.requests)
+	p.writeHistograms(cw, "api_request_duration_seconds", "Latency of Bot API requests.", "method", p.requestLatency)
+	p.writeCounter(cw, "updates_total", "Updates passed to handlers.", "type", "result", p.updates)
+	p.writeHistograms(cw, "update_handling_duration_seconds", "Time spent in update handlers.", "type", p.updateDuration)
+	p.writeHistograms(cw, "update_lag_seconds", "Time between the date of an update and the start of its handling.", "type", p.updateLag)
+
+	if cw.err != nil {
+		return cw.n, cw.err
+	}
+	return cw.n, cw.w.Flush()
+}
+
+func (p *Prometheus) writeCounter(w *countingWriter, name, help, label1, label2 string, values map[[2]string]uint64) {
+	name = p.namespace + "_" + name
+	w.printf("# HELP %s %s\n# TYPE %s counter\n", name, help, name)
+
+	keys := make([][2]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i][0] != keys[j][0] {
+			return keys[i][0] < keys[j][0]
+		}
+		return keys[i][1] < keys[j][1]
+	})
+	for _, k := range keys {
+		w.printf("%s{%s=%q,%s=%q} %d\n", name, label1, escapeLabel(k[0]), label2, escapeLabel(k[1]), values[k])
+	}
+}
+
+func (p *Prometheus) writeHistograms(w *countingWriter, name, help, label string, values map[string]*histogram) {
+	name = p.namespace + "_" + name
+	w.printf("# HELP %s %s\n# TYPE %s histogram\n", name, help, name)
+
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		h := values[k]
+		value := escapeLabel(k)
+		cumulative := uint64(0)
+		for i, bound := range h.bounds {
+			cumulative += h.counts[i]
+			w.printf("%s_bucket{%s=%q,le=%q} %d\n", name, label, value, formatFloat(bound), cumulative)
+		}
+		w.printf("%s_bucket{%s=%q,le=\"+Inf\"} %d\n", name, label, value, h.count)
+		w.printf("%s_sum{%s=%q} %s\n", name, label, value, formatFloat(h.sum))
+		w.printf("%s_count{%s=%q} %d\n", name, label, value, h.count)
+	}
+}
+
+type histogram struct {
+	bounds []float64
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+func (h *histogram) observe(d time.Duration) {
+	seconds := d.Seconds()
+	h.count++
+	h.sum += seconds
+	for i, bound := range h.bounds {
+		if seconds <= bound {
+			h.counts[i]++
+			return
+		}
+	}
+}
+
+type countingWriter struct {
+	w   *bufio.Writer
+	n   int64
+	err error
+}
+
+func (w *countingWriter) printf(format string, args ...any) {
+	if w.err != nil {
+		return
+	}
+	n, err := fmt.Fprintf(w.w, format, args...)
+	w.n += int64(n)
+	w.err = err
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// escapeLabel prepares a label value for %q, which already escapes
+// backslashes, quotes and newlines the way the exposition format expects,
+// by dropping other control characters that %q would render as \x escapes.
+func escapeLabel(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r < 0x20 && r != '\n' {
+			return -1
+		}
+		return r
+	}, s)
+}
@@ -0,0 +1,38 @@
+package telegram
+
+import (
+	"context"
+	"net/http"
+)
+
+// APICall is a Bot API call as seen by middleware. Middleware may change any
+// field before passing the call on, for example to add parameters or to
+// switch to a rotated bot token.
+type APICall struct {
+	// Method is the Bot API method name, such as "sendMessage".
+	Method string
+	Params map[string]any
+	Header http.Header
+	Token  string
+}
+
+// CallFunc performs a call and returns the raw response body.
+type CallFunc func(ctx context.Context, call *APICall) ([]byte, error)
+
+type Middleware func(next CallFunc) CallFunc
+
+// Use appends middleware to the chain wrapped around every call. The first
+// middleware added is the outermost one. Middleware sees each call once;
+// retries and rate limiting happen inside the chain. Use is not safe to call
+// concurrently with calls in flight.
+func (c *Client) Use(mw ...Middleware) {
+	c.middlewares = append(c.middlewares, mw...)
+}
+
+func (c *Client) chain(final CallFunc) CallFunc {
+	next := final
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		next = c.middlewares[i](next)
+	}
+	return next
+}
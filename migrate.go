@@ -0,0 +1,68 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strconv"
+	"telegram/common"
+)
+
+// migrateChat handles a call rejected because its group was upgraded to a
+// supergroup. It reports the migration through OnChatMigrate and, when
+// MigrateChats is set, points the request at the new chat so it can be sent
+// again. It returns true if the request should be retried.
+func (c *Client) migrateChat(ctx context.Context, r *request, err error) bool {
+	apiErr := new(common.APIError)
+	if err == nil || !errors.As(err, &apiErr) {
+		return false
+	}
+	toChatID := apiErr.MigrateToChatID()
+	if toChatID == 0 {
+		return false
+	}
+
+	param, fromChatID := migratedChat(r)
+	if param == "" {
+		return false
+	}
+	if c.OnChatMigrate != nil {
+		c.OnChatMigrate(ctx, fromChatID, toChatID)
+	}
+
+	if !c.MigrateChats || !r.replayable() {
+		return false
+	}
+	c.logger().LogAttrs(ctx, slog.LevelInfo, "telegram: resending call to migrated chat",
+		slog.String("method", r.apiMethod()),
+		slog.Int64("from_chat_id", fromChatID),
+		slog.Int64("to_chat_id", toChatID),
+	)
+	r.setParam(param, toChatID)
+	return true
+}
+
+// migratedChat returns the parameter naming the group a migration error is
+// about, and its ID. Only basic groups migrate, so when a call names more
+// than one, as forwardMessage does with from_chat_id, the error is attributed
+// only if exactly one of them is a basic group; otherwise it returns "".
+func migratedChat(r *request) (string, int64) {
+	param, chatID := "", int64(0)
+	for _, key := range []string{"chat_id", "from_chat_id"} {
+		id, err := strconv.ParseInt(formatParam(r.params[key]), 10, 64)
+		if err != nil || !isBasicGroupID(id) {
+			continue
+		}
+		if param != "" {
+			return "", 0
+		}
+		param, chatID = key, id
+	}
+	return param, chatID
+}
+
+// isBasicGroupID reports whether id belongs to a basic group rather than a
+// user, or a supergroup or channel, whose IDs start with -100.
+func isBasicGroupID(id int64) bool {
+	return id < 0 && id > -1000000000000
+}
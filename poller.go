@@ -0,0 +1,244 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"telegram/common"
+	"time"
+)
+
+type UpdateHandler interface {
+	HandleUpdate(ctx context.Context, update *Update) error
+}
+
+type UpdateHandlerFunc func(ctx context.Context, update *Update) error
+
+func (f UpdateHandlerFunc) HandleUpdate(ctx context.Context, update *Update) error {
+	return f(ctx, update)
+}
+
+// Poller fetches updates with getUpdates and hands them to a fixed pool of
+// workers. Updates that belong to the same chat always land on the same
+// worker, so they are handled in the order Telegram delivered them.
+type Poller struct {
+	c              *Client
+	offset         int64
+	timeout        int64
+	limit          int64
+	allowedUpdates []string
+	workers        int
+	minBackoff     time.Duration
+	maxBackoff     time.Duration
+	errorHandler   func(error)
+}
+
+func (c *Client) NewPoller() *Poller {
+	return &Poller{
+		c:          c,
+		timeout:    30,
+		limit:      100,
+		workers:    1,
+		minBackoff: time.Second,
+		maxBackoff: 30 * time.Second,
+	}
+}
+
+// StartPolling runs a Poller with the default settings until ctx is done.
+func (c *Client) StartPolling(ctx context.Context, handler UpdateHandler) error {
+	return c.NewPoller().Start(ctx, handler)
+}
+
+func (p *Poller) Offset(offset int64) *Poller {
+	p.offset = offset
+	return p
+}
+
+func (p *Poller) Timeout(timeout int64) *Poller {
+	p.timeout = timeout
+	return p
+}
+
+// Limit sets how many updates each getUpdates call fetches, which is also
+// the queue size of each worker. It is clamped to Telegram's range of 1 to
+// 100.
+func (p *Poller) Limit(limit int64) *Poller {
+	p.limit = limit
+	return p
+}
+
+func (p *Poller) AllowedUpdates(allowedUpdates []string) *Poller {
+	p.allowedUpdates = allowedUpdates
+	return p
+}
+
+func (p *Poller) Workers(workers int) *Poller {
+	p.workers = workers
+	return p
+}
+
+// Backoff sets the delays between failed getUpdates calls, doubling from min
+// up to max. A non-positive min falls back to one second, and max is raised
+// to min when it is smaller.
+func (p *Poller) Backoff(min, max time.Duration) *Poller {
+	p.minBackoff = min
+	p.maxBackoff = max
+	return p
+}
+
+func (p *Poller) ErrorHandler(errorHandler func(error)) *Poller {
+	p.errorHandler = errorHandler
+	return p
+}
+
+// Start blocks until ctx is done or the bot token is rejected. On shutdown it
+// stops fetching, waits for the updates already queued to be handled and
+// then acknowledges them so they are not delivered again.
+func (p *Poller) Start(ctx context.Context, handler UpdateHandler) error {
+	workers := p.workers
+	if workers < 1 {
+		workers = 1
+	}
+	// Telegram accepts limits from 1 to 100.
+	limit := min(max(p.limit, 1), 100)
+
+	// Handlers keep running after ctx is cancelled so the queue can drain.
+	handlerCtx := context.WithoutCancel(ctx)
+	queues := make([]chan *Update, workers)
+	wg := sync.WaitGroup{}
+	for i := range queues {
+		queues[i] = make(chan *Update, limit)
+		wg.Add(1)
+		go func(queue chan *Update) {
+			defer wg.Done()
+			for update := range queue {
+				p.handle(handlerCtx, handler, update)
+			}
+		}(queues[i])
+	}
+
+	minBackoff := p.minBackoff
+	if minBackoff <= 0 {
+		minBackoff = time.Second
+	}
+	maxBackoff := max(p.maxBackoff, minBackoff)
+
+	offset := p.offset
+	backoff := minBackoff
+	err := func() error {
+		for ctx.Err() == nil {
+			res, err := p.c.NewGetUpdatesService().
+				Offset(offset).
+				Limit(limit).
+				Timeout(p.timeout).
+				AllowedUpdates(p.allowedUpdates).
+				Do(ctx)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				if isFatalPollingError(err) {
+					return err
+				}
+				p.reportError(err)
+				if sleepContext(ctx, backoff) != nil {
+					return nil
+				}
+				backoff = min(backoff*2, maxBackoff)
+				continue
+			}
+			backoff = minBackoff
+
+			for i := range res {
+				update := &res[i]
+				if update.UpdateID < offset {
+					continue
+				}
+				select {
+				case queues[updateShard(update, workers)] <- update:
+					offset = update.UpdateID + 1
+				case <-ctx.Done():
+					return nil
+				}
+			}
+		}
+		return nil
+	}()
+
+	for _, queue := range queues {
+		close(queue)
+	}
+	wg.Wait()
+
+	if offset > p.offset {
+		p.acknowledge(offset)
+	}
+	return err
+}
+
+func (p *Poller) handle(ctx context.Context, handler UpdateHandler, update *Update) {
+	defer func() {
+		if r := recover(); r != nil {
+			p.reportError(fmt.Errorf("panic while handling update %d: %v", update.UpdateID, r))
+		}
+	}()
+
+	err := p.c.handleUpdate(ctx, handler, update)
+	if err != nil {
+		p.reportError(fmt.Errorf("update %d: %w", update.UpdateID, err))
+	}
+}
+
+// acknowledge confirms every update below offset. Telegram only marks updates
+// as processed once getUpdates is called with a higher offset, so without this
+// the last batch would be delivered again after a restart.
+func (p *Poller) acknowledge(offset int64) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := p.c.NewGetUpdatesService().
+		Offset(offset).
+		Limit(1).
+		Timeout(0).
+		Do(ctx)
+	if err != nil {
+		p.reportError(err)
+	}
+}
+
+func (p *Poller) reportError(err error) {
+	if p.errorHandler != nil {
+		p.errorHandler(err)
+		return
+	}
+	p.c.logger().Error("telegram: polling failed", "error", err)
+}
+
+func isFatalPollingError(err error) bool {
+	return errors.Is(err, common.ErrUnauthorized) || errors.Is(err, common.ErrNotFound)
+}
+
+func updateShard(update *Update, workers int) int {
+	key := update.EffectiveChatID()
+	if key == 0 {
+		if user := update.EffectiveUser(); user != nil {
+			key = user.ID
+		} else {
+			key = update.UpdateID
+		}
+	}
+	return int(uint64(key) % uint64(workers))
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
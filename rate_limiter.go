@@ -0,0 +1,147 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+)
+
+var ErrRateLimited = errors.New("rate limit exceeded")
+
+// RateLimiter is consulted before every call that sends a message, such as
+// sendMessage, copyMessage or forwardMessage. chatID is the raw chat_id
+// parameter of the call.
+type RateLimiter interface {
+	Wait(ctx context.Context, chatID string) error
+}
+
+// sendsMessage reports whether method posts a message to a chat, which is
+// what Telegram's broadcast limits count.
+func sendsMessage(method string) bool {
+	switch method {
+	case "copyMessage", "copyMessages", "forwardMessage", "forwardMessages":
+		return true
+	case "sendChatAction":
+		return false
+	}
+	return strings.HasPrefix(method, "send")
+}
+
+type RateLimitMode int
+
+const (
+	// RateLimitWait blocks until the call is allowed or ctx is done.
+	RateLimitWait RateLimitMode = iota
+	// RateLimitFailFast returns ErrRateLimited instead of waiting.
+	RateLimitFailFast
+)
+
+type Rate struct {
+	Count int
+	Per   time.Duration
+}
+
+func (r Rate) interval() time.Duration {
+	if r.Count <= 0 {
+		return 0
+	}
+	return r.Per / time.Duration(r.Count)
+}
+
+type RateLimits struct {
+	Global  Rate
+	Private Rate
+	Group   Rate
+}
+
+// DefaultRateLimits follows the limits documented in the Bot FAQ: about 30
+// messages per second overall, one per second in a private chat and 20 per
+// minute in a group or channel.
+var DefaultRateLimits = RateLimits{
+	Global:  Rate{Count: 30, Per: time.Second},
+	Private: Rate{Count: 1, Per: time.Second},
+	Group:   Rate{Count: 20, Per: time.Minute},
+}
+
+// ChatRateLimiter spaces calls evenly so that neither the global nor the
+// per-chat limit is exceeded. Positive chat IDs are private chats; negative
+// IDs and @usernames are treated as groups and channels.
+type ChatRateLimiter struct {
+	mode      RateLimitMode
+	limits    RateLimits
+	mu        sync.Mutex
+	global    time.Time
+	chats     map[string]time.Time
+	lastSweep time.Time
+}
+
+func NewChatRateLimiter(mode RateLimitMode, limits RateLimits) *ChatRateLimiter {
+	return &ChatRateLimiter{
+		mode:   mode,
+		limits: limits,
+		chats:  make(map[string]time.Time),
+	}
+}
+
+func (l *ChatRateLimiter) Wait(ctx context.Context, chatID string) error {
+	if chatID == "" {
+		return nil
+	}
+
+	wait, err := l.reserve(chatID, time.Now())
+	if err != nil || wait <= 0 {
+		return err
+	}
+	return sleepContext(ctx, wait)
+}
+
+// reserve books the earliest slot allowed by both the global and the chat
+// limit and returns how long the caller has to wait for it.
+func (l *ChatRateLimiter) reserve(chatID string, now time.Time) (time.Duration, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.sweep(now)
+
+	chatRate := l.limits.Group
+	if isPrivateChatID(chatID) {
+		chatRate = l.limits.Private
+	}
+
+	at := now
+	if l.global.After(at) {
+		at = l.global
+	}
+	if next := l.chats[chatID]; next.After(at) {
+		at = next
+	}
+
+	wait := at.Sub(now)
+	if wait > 0 && l.mode == RateLimitFailFast {
+		return 0, ErrRateLimited
+	}
+
+	l.global = at.Add(l.limits.Global.interval())
+	l.chats[chatID] = at.Add(chatRate.interval())
+	return wait, nil
+}
+
+// sweep forgets chats whose next slot is already in the past, at most once a
+// minute, so the map does not grow with every chat ever contacted.
+func (l *ChatRateLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < time.Minute {
+		return
+	}
+	l.lastSweep = now
+	for chatID, next := range l.chats {
+		if next.Before(now) {
+			delete(l.chats, chatID)
+		}
+	}
+}
+
+func isPrivateChatID(chatID string) bool {
+	return !strings.HasPrefix(chatID, "-") && !strings.HasPrefix(chatID, "@")
+}
@@ -5,6 +5,8 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"reflect"
+	"strings"
 )
 
 type secType int
@@ -14,36 +16,98 @@ type params map[string]any
 type request struct {
 	method     string
 	endpoint   string
+	token      string
 	query      url.Values
-	form       url.Values
+	params     params
 	recvWindow int64
 	secType    secType
 	header     http.Header
 	body       io.Reader
 	fullURL    string
-	json       []byte
+	files      []requestFile
+	progress   UploadProgressFunc
+}
+
+type requestFile struct {
+	field string
+	file  InputFile
 }
 
 type RequestOption func(*request)
 
-func (r *request) setFormParams(m params) *request {
-	json, err := json.MarshalIndent(m, "", "   ")
-	if err != nil {
-		return nil
+func (r *request) setParam(key string, value interface{}) *request {
+	if r.params == nil {
+		r.params = params{}
 	}
-	r.json = json
+	r.params[key] = value
+	return r
+}
+
+func (r *request) setParams(m params) *request {
 	for k, v := range m {
-		r.setFormParam(k, v)
+		r.setParam(k, v)
 	}
 	return r
 }
 
-func (r *request) setFormParam(key string, value interface{}) *request {
-	if r.form == nil {
-		r.form = url.Values{}
+func (r *request) setFile(field string, file InputFile) *request {
+	r.files = append(r.files, requestFile{field: field, file: file})
+	return r
+}
+
+func (r *request) chatID() string {
+	return formatParam(r.params["chat_id"])
+}
+
+// values flattens the parameters into strings for query strings and
+// multipart forms. Objects and arrays are sent as JSON, as the Bot API
+// expects.
+func (r *request) values() url.Values {
+	values := url.Values{}
+	for k, v := range r.params {
+		if isNil(v) {
+			continue
+		}
+		values.Set(k, formatParam(v))
 	}
+	return values
+}
 
-	r.form.Set(key, fmt.Sprintf("%v", value))
+func formatParam(value interface{}) string {
+	if isNil(value) {
+		return ""
+	}
+	switch v := value.(type) {
+	case string:
+		return v
+	case fmt.Stringer:
+		return v.String()
+	}
 
-	return r
+	switch reflect.TypeOf(value).Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map, reflect.Struct, reflect.Ptr:
+		data, err := json.Marshal(value)
+		if err == nil {
+			return string(data)
+		}
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+func (r *request) apiMethod() string {
+	return strings.TrimPrefix(r.endpoint, "/")
+}
+
+// isNil reports whether value is nil or a nil pointer, map, slice or
+// interface, none of which make a parameter.
+func isNil(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface, reflect.Func, reflect.Chan:
+		return v.IsNil()
+	}
+	return false
 }
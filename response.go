@@ -0,0 +1,54 @@
+package telegram
+
+import (
+	"telegram/common"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// response is the envelope every Bot API response is wrapped in.
+type response[T any] struct {
+	Ok          bool                       `json:"ok"`
+	Result      T                          `json:"result"`
+	ErrorCode   int                        `json:"error_code"`
+	Description string                     `json:"description"`
+	Parameters  *common.ResponseParameters `json:"parameters"`
+}
+
+// decodeResult unwraps the result of a response, reporting responses with
+// ok set to false as an *common.APIError.
+func decodeResult[T any](data []byte) (res T, err error) {
+	resp := new(response[T])
+	err = json.Unmarshal(data, resp)
+	if err != nil {
+		return res, err
+	}
+	if !resp.Ok {
+		return res, &common.APIError{
+			Ok:          resp.Ok,
+			ErrorCode:   resp.ErrorCode,
+			Description: resp.Description,
+			Parameters:  resp.Parameters,
+		}
+	}
+	return resp.Result, nil
+}
+
+// decodeEditResult decodes the result of edit methods, which is the edited
+// message, or true when the message was sent in inline mode and has no
+// Message to return.
+func decodeEditResult(data []byte) (*Message, error) {
+	raw, err := decodeResult[jsoniter.RawMessage](data)
+	if err != nil {
+		return nil, err
+	}
+	if string(raw) == "true" {
+		return nil, nil
+	}
+	message := new(Message)
+	err = json.Unmarshal(raw, message)
+	if err != nil {
+		return nil, err
+	}
+	return message, nil
+}
@@ -0,0 +1,54 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"telegram/common"
+	"time"
+)
+
+// RetryPolicy controls how calls rejected with 429 Too Many Requests are
+// retried. The client waits for the retry_after interval reported by
+// Telegram, but never past the deadline of the request context.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt.
+	MaxRetries int
+	// MaxWait caps a single retry_after wait. Calls asked to wait longer fail
+	// immediately. Zero means no cap.
+	MaxWait time.Duration
+}
+
+func (c *Client) shouldRetry(ctx context.Context, r *request, err error, attempt int) (time.Duration, bool) {
+	p := c.RetryPolicy
+	if p == nil || err == nil || attempt >= p.MaxRetries || !r.replayable() {
+		return 0, false
+	}
+
+	apiErr := new(common.APIError)
+	if !errors.As(err, &apiErr) || apiErr.ErrorCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+	wait := time.Duration(apiErr.RetryAfter()) * time.Second
+	if wait <= 0 {
+		return 0, false
+	}
+	if p.MaxWait > 0 && wait > p.MaxWait {
+		return 0, false
+	}
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+		return 0, false
+	}
+	return wait, true
+}
+
+// replayable reports whether r can be sent again. Files backed by an
+// io.Reader are consumed by the first attempt.
+func (r *request) replayable() bool {
+	for _, f := range r.files {
+		if f.file.Path == "" && f.file.Bytes == nil {
+			return false
+		}
+	}
+	return true
+}
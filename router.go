@@ -0,0 +1,95 @@
+package telegram
+
+import (
+	"context"
+)
+
+// HandlerFunc handles an update dispatched by a Router.
+type HandlerFunc func(ctx *Context) error
+
+// Router dispatches updates to the first route registered for their kind
+// whose filters all match. Updates that match no route go to the fallback
+// handler, if any. Routes must be registered before the router starts
+// receiving updates.
+type Router struct {
+	c        *Client
+	routes   []route
+	fallback HandlerFunc
+	bot      botUsername
+
+	conversations []*Conversation
+}
+
+type route struct {
+	updateType string
+	filters    []Filter
+	handler    HandlerFunc
+}
+
+func (c *Client) NewRouter() *Router {
+	return &Router{c: c}
+}
+
+// Handle registers handler for updates of the given UpdateType.
+func (r *Router) Handle(updateType string, handler HandlerFunc, filters ...Filter) *Router {
+	r.routes = append(r.routes, route{updateType: updateType, filters: filters, handler: handler})
+	return r
+}
+
+func (r *Router) Message(handler HandlerFunc, filters ...Filter) *Router {
+	return r.Handle(UpdateTypeMessage, handler, filters...)
+}
+
+func (r *Router) EditedMessage(handler HandlerFunc, filters ...Filter) *Router {
+	return r.Handle(UpdateTypeEditedMessage, handler, filters...)
+}
+
+func (r *Router) ChannelPost(handler HandlerFunc, filters ...Filter) *Router {
+	return r.Handle(UpdateTypeChannelPost, handler, filters...)
+}
+
+func (r *Router) CallbackQuery(handler HandlerFunc, filters ...Filter) *Router {
+	return r.Handle(UpdateTypeCallbackQuery, handler, filters...)
+}
+
+func (r *Router) InlineQuery(handler HandlerFunc, filters ...Filter) *Router {
+	return r.Handle(UpdateTypeInlineQuery, handler, filters...)
+}
+
+func (r *Router) ChatMember(handler HandlerFunc, filters ...Filter) *Router {
+	return r.Handle(UpdateTypeChatMember, handler, filters...)
+}
+
+func (r *Router) MyChatMember(handler HandlerFunc, filters ...Filter) *Router {
+	return r.Handle(UpdateTypeMyChatMember, handler, filters...)
+}
+
+func (r *Router) PollAnswer(handler HandlerFunc, filters ...Filter) *Router {
+	return r.Handle(UpdateTypePollAnswer, handler, filters...)
+}
+
+// Fallback sets the handler for updates that match no route.
+func (r *Router) Fallback(handler HandlerFunc) *Router {
+	r.fallback = handler
+	return r
+}
+
+func (r *Router) HandleUpdate(ctx context.Context, update *Update) error {
+	for _, conv := range r.conversations {
+		handled, err := conv.handle(r.c.newContext(ctx, update))
+		if handled {
+			return err
+		}
+	}
+
+	updateType := update.Type()
+	for _, route := range r.routes {
+		if route.updateType == updateType && matchAll(ctx, update, route.filters) {
+			return route.handler(r.c.newContext(ctx, update))
+		}
+	}
+	if r.fallback != nil {
+		return r.fallback(r.c.newContext(ctx, update))
+	}
+	return nil
+}
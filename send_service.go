@@ -2,8 +2,8 @@ package telegram
 
 import (
 	"context"
+	"fmt"
 	"net/http"
-	"telegram/common"
 
 	jsoniter "github.com/json-iterator/go"
 )
@@ -131,9 +131,9 @@ func (t *SendMessageService) ForceReply(forceReply ForceReply) *SendMessageServi
 	return t
 }
 
-func (t *SendMessageService) Do(ctx context.Context, opts ...RequestOption) (res []*SendMessage, err error) {
+func (t *SendMessageService) Do(ctx context.Context, opts ...RequestOption) (res *Message, err error) {
 	r := &request{
-		method:   http.MethodGet,
+		method:   http.MethodPost,
 		endpoint: "/sendMessage",
 	}
 
@@ -180,22 +180,7 @@ func (t *SendMessageService) Do(ctx context.Context, opts ...RequestOption) (res
 	if err != nil {
 		return nil, err
 	}
-	data = common.ToJSONList(data)
-	if err != nil {
-		return nil, err
-	}
-
-	res = make([]*SendMessage, 0)
-	err = json.Unmarshal(data, &res)
-	if err != nil {
-		return nil, err
-	}
-	return res, nil
-}
-
-type SendMessage struct {
-	Ok     bool    `json:"ok"`
-	Result Message `json:"result"`
+	return decodeResult[*Message](data)
 }
 
 type ForwardMessageService struct {
@@ -238,9 +223,9 @@ func (t *ForwardMessageService) ProtectContent(protectContent bool) *ForwardMess
 	return t
 }
 
-func (t *ForwardMessageService) Do(ctx context.Context, opts ...RequestOption) (res []*ForwardMessage, err error) {
+func (t *ForwardMessageService) Do(ctx context.Context, opts ...RequestOption) (res *Message, err error) {
 	r := &request{
-		method:   http.MethodGet,
+		method:   http.MethodPost,
 		endpoint: "/forwardMessage",
 	}
 
@@ -261,22 +246,7 @@ func (t *ForwardMessageService) Do(ctx context.Context, opts ...RequestOption) (
 	if err != nil {
 		return nil, err
 	}
-	data = common.ToJSONList(data)
-	if err != nil {
-		return nil, err
-	}
-
-	res = make([]*ForwardMessage, 0)
-	err = json.Unmarshal(data, &res)
-	if err != nil {
-		return nil, err
-	}
-	return res, nil
-}
-
-type ForwardMessage struct {
-	Ok     bool    `json:"ok"`
-	Result Message `json:"result"`
+	return decodeResult[*Message](data)
 }
 
 type CopyMessageService struct {
@@ -308,6 +278,11 @@ func (t *CopyMessageService) MessageThreadID(messageThreadID int64) *CopyMessage
 	return t
 }
 
+func (t *CopyMessageService) Caption(caption string) *CopyMessageService {
+	t.caption = &caption
+	return t
+}
+
 func (t *CopyMessageService) ParseMode(parseMode string) *CopyMessageService {
 	t.parseMode = &parseMode
 	return t
@@ -404,9 +379,9 @@ func (t *CopyMessageService) ForceReply(forceReply ForceReply) *CopyMessageServi
 	return t
 }
 
-func (t *CopyMessageService) Do(ctx context.Context, opts ...RequestOption) (res []*CopyMessage, err error) {
+func (t *CopyMessageService) Do(ctx context.Context, opts ...RequestOption) (res *MessageID, err error) {
 	r := &request{
-		method:   http.MethodGet,
+		method:   http.MethodPost,
 		endpoint: "/copyMessage",
 	}
 
@@ -416,6 +391,9 @@ func (t *CopyMessageService) Do(ctx context.Context, opts ...RequestOption) (res
 	if t.messageThreadID != nil {
 		r.setParam("message_thread_id", *t.messageThreadID)
 	}
+	if t.caption != nil {
+		r.setParam("caption", *t.caption)
+	}
 	if t.parseMode != nil {
 		r.setParam("parse_mode", *t.parseMode)
 	}
@@ -451,22 +429,7 @@ func (t *CopyMessageService) Do(ctx context.Context, opts ...RequestOption) (res
 	if err != nil {
 		return nil, err
 	}
-	data = common.ToJSONList(data)
-	if err != nil {
-		return nil, err
-	}
-
-	res = make([]*CopyMessage, 0)
-	err = json.Unmarshal(data, &res)
-	if err != nil {
-		return nil, err
-	}
-	return res, nil
-}
-
-type CopyMessage struct {
-	Ok     bool    `json:"ok"`
-	Result Message `json:"result"`
+	return decodeResult[*MessageID](data)
 }
 
 type SendPhotoService struct {
@@ -498,6 +461,11 @@ func (t *SendPhotoService) MessageThreadID(messageThreadID int64) *SendPhotoServ
 	return t
 }
 
+func (t *SendPhotoService) Caption(caption string) *SendPhotoService {
+	t.caption = &caption
+	return t
+}
+
 func (t *SendPhotoService) ParseMode(parseMode string) *SendPhotoService {
 	t.parseMode = &parseMode
 	return t
@@ -594,15 +562,15 @@ func (t *SendPhotoService) ForceReply(forceReply ForceReply) *SendPhotoService {
 	return t
 }
 
-func (t *SendPhotoService) Do(ctx context.Context, opts ...RequestOption) (res []*SendPhoto, err error) {
+func (t *SendPhotoService) Do(ctx context.Context, opts ...RequestOption) (res *Message, err error) {
 	r := &request{
-		method:   http.MethodGet,
+		method:   http.MethodPost,
 		endpoint: "/sendPhoto",
 	}
 
 	r.setParam("chat_id", *t.chatID)
 	if t.photo != nil {
-		r.setParam("photo", *t.photo)
+		r.setFile("photo", *t.photo)
 	}
 	if t.photoString != nil {
 		r.setParam("photo", *t.photoString)
@@ -610,6 +578,9 @@ func (t *SendPhotoService) Do(ctx context.Context, opts ...RequestOption) (res [
 	if t.messageThreadID != nil {
 		r.setParam("message_thread_id", *t.messageThreadID)
 	}
+	if t.caption != nil {
+		r.setParam("caption", *t.caption)
+	}
 	if t.parseMode != nil {
 		r.setParam("parse_mode", *t.parseMode)
 	}
@@ -645,22 +616,7 @@ func (t *SendPhotoService) Do(ctx context.Context, opts ...RequestOption) (res [
 	if err != nil {
 		return nil, err
 	}
-	data = common.ToJSONList(data)
-	if err != nil {
-		return nil, err
-	}
-
-	res = make([]*SendPhoto, 0)
-	err = json.Unmarshal(data, &res)
-	if err != nil {
-		return nil, err
-	}
-	return res, nil
-}
-
-type SendPhoto struct {
-	Ok     bool    `json:"ok"`
-	Result Message `json:"result"`
+	return decodeResult[*Message](data)
 }
 
 type SendAudioService struct {
@@ -697,6 +653,11 @@ func (t *SendAudioService) MessageThreadID(messageThreadID int64) *SendAudioServ
 	return t
 }
 
+func (t *SendAudioService) Caption(caption string) *SendAudioService {
+	t.caption = &caption
+	return t
+}
+
 func (t *SendAudioService) ParseMode(parseMode string) *SendAudioService {
 	t.parseMode = &parseMode
 	return t
@@ -818,15 +779,15 @@ func (t *SendAudioService) ForceReply(forceReply ForceReply) *SendAudioService {
 	return t
 }
 
-func (t *SendAudioService) Do(ctx context.Context, opts ...RequestOption) (res []*SendAudio, err error) {
+func (t *SendAudioService) Do(ctx context.Context, opts ...RequestOption) (res *Message, err error) {
 	r := &request{
-		method:   http.MethodGet,
+		method:   http.MethodPost,
 		endpoint: "/sendAudio",
 	}
 
 	r.setParam("chat_id", *t.chatID)
 	if t.audio != nil {
-		r.setParam("audio", *t.audio)
+		r.setFile("audio", *t.audio)
 	}
 	if t.audioString != nil {
 		r.setParam("audio", *t.audioString)
@@ -834,6 +795,9 @@ func (t *SendAudioService) Do(ctx context.Context, opts ...RequestOption) (res [
 	if t.messageThreadID != nil {
 		r.setParam("message_thread_id", *t.messageThreadID)
 	}
+	if t.caption != nil {
+		r.setParam("caption", *t.caption)
+	}
 	if t.parseMode != nil {
 		r.setParam("parse_mode", *t.parseMode)
 	}
@@ -850,7 +814,7 @@ func (t *SendAudioService) Do(ctx context.Context, opts ...RequestOption) (res [
 		r.setParam("title", *t.title)
 	}
 	if t.thumbnail != nil {
-		r.setParam("thumbnail", *t.thumbnail)
+		r.setFile("thumbnail", *t.thumbnail)
 	}
 	if t.thumbnailString != nil {
 		r.setParam("thumbnail", *t.thumbnailString)
@@ -884,22 +848,7 @@ func (t *SendAudioService) Do(ctx context.Context, opts ...RequestOption) (res [
 	if err != nil {
 		return nil, err
 	}
-	data = common.ToJSONList(data)
-	if err != nil {
-		return nil, err
-	}
-
-	res = make([]*SendAudio, 0)
-	err = json.Unmarshal(data, &res)
-	if err != nil {
-		return nil, err
-	}
-	return res, nil
-}
-
-type SendAudio struct {
-	Ok     bool    `json:"ok"`
-	Result Message `json:"result"`
+	return decodeResult[*Message](data)
 }
 
 type SendDocumentService struct {
@@ -933,6 +882,11 @@ func (t *SendDocumentService) MessageThreadID(messageThreadID int64) *SendDocume
 	return t
 }
 
+func (t *SendDocumentService) Caption(caption string) *SendDocumentService {
+	t.caption = &caption
+	return t
+}
+
 func (t *SendDocumentService) ParseMode(parseMode string) *SendDocumentService {
 	t.parseMode = &parseMode
 	return t
@@ -1039,19 +993,25 @@ func (t *SendDocumentService) ForceReply(forceReply ForceReply) *SendDocumentSer
 	return t
 }
 
-func (t *SendDocumentService) Do(ctx context.Context, opts ...RequestOption) (res []*SendDocument, err error) {
+func (t *SendDocumentService) Do(ctx context.Context, opts ...RequestOption) (res *Message, err error) {
 	r := &request{
-		method:   http.MethodGet,
+		method:   http.MethodPost,
 		endpoint: "/sendDocument",
 	}
 
 	r.setParam("chat_id", *t.chatID)
 	if t.document != nil {
-		r.setParam("document", *t.document)
+		r.setFile("document", *t.document)
 	}
 	if t.documentString != nil {
 		r.setParam("document", *t.documentString)
 	}
+	if t.thumbnail != nil {
+		r.setFile("thumbnail", *t.thumbnail)
+	}
+	if t.thumbnailString != nil {
+		r.setParam("thumbnail", *t.thumbnailString)
+	}
 	if t.messageThreadID != nil {
 		r.setParam("message_thread_id", *t.messageThreadID)
 	}
@@ -1093,22 +1053,7 @@ func (t *SendDocumentService) Do(ctx context.Context, opts ...RequestOption) (re
 	if err != nil {
 		return nil, err
 	}
-	data = common.ToJSONList(data)
-	if err != nil {
-		return nil, err
-	}
-
-	res = make([]*SendDocument, 0)
-	err = json.Unmarshal(data, &res)
-	if err != nil {
-		return nil, err
-	}
-	return res, nil
-}
-
-type SendDocument struct {
-	Ok     bool    `json:"ok"`
-	Result Message `json:"result"`
+	return decodeResult[*Message](data)
 }
 
 type SendVideoService struct {
@@ -1147,6 +1092,11 @@ func (t *SendVideoService) MessageThreadID(messageThreadID int64) *SendVideoServ
 	return t
 }
 
+func (t *SendVideoService) Caption(caption string) *SendVideoService {
+	t.caption = &caption
+	return t
+}
+
 func (t *SendVideoService) ParseMode(parseMode string) *SendVideoService {
 	t.parseMode = &parseMode
 	return t
@@ -1278,19 +1228,25 @@ func (t *SendVideoService) ForceReply(forceReply ForceReply) *SendVideoService {
 	return t
 }
 
-func (t *SendVideoService) Do(ctx context.Context, opts ...RequestOption) (res []*SendVideo, err error) {
+func (t *SendVideoService) Do(ctx context.Context, opts ...RequestOption) (res *Message, err error) {
 	r := &request{
-		method:   http.MethodGet,
+		method:   http.MethodPost,
 		endpoint: "/sendVideo",
 	}
 
 	r.setParam("chat_id", *t.chatID)
 	if t.video != nil {
-		r.setParam("video", *t.video)
+		r.setFile("video", *t.video)
 	}
 	if t.videoString != nil {
 		r.setParam("video", *t.videoString)
 	}
+	if t.thumbnail != nil {
+		r.setFile("thumbnail", *t.thumbnail)
+	}
+	if t.thumbnailString != nil {
+		r.setParam("thumbnail", *t.thumbnailString)
+	}
 	if t.duration != nil {
 		r.setParam("duration", *t.duration)
 	}
@@ -1347,22 +1303,7 @@ func (t *SendVideoService) Do(ctx context.Context, opts ...RequestOption) (res [
 	if err != nil {
 		return nil, err
 	}
-	data = common.ToJSONList(data)
-	if err != nil {
-		return nil, err
-	}
-
-	res = make([]*SendVideo, 0)
-	err = json.Unmarshal(data, &res)
-	if err != nil {
-		return nil, err
-	}
-	return res, nil
-}
-
-type SendVideo struct {
-	Ok     bool    `json:"ok"`
-	Result Message `json:"result"`
+	return decodeResult[*Message](data)
 }
 
 type SendAnimationService struct {
@@ -1400,6 +1341,11 @@ func (t *SendAnimationService) MessageThreadID(messageThreadID int64) *SendAnima
 	return t
 }
 
+func (t *SendAnimationService) Caption(caption string) *SendAnimationService {
+	t.caption = &caption
+	return t
+}
+
 func (t *SendAnimationService) ParseMode(parseMode string) *SendAnimationService {
 	t.parseMode = &parseMode
 	return t
@@ -1526,19 +1472,25 @@ func (t *SendAnimationService) ForceReply(forceReply ForceReply) *SendAnimationS
 	return t
 }
 
-func (t *SendAnimationService) Do(ctx context.Context, opts ...RequestOption) (res []*SendAnimation, err error) {
+func (t *SendAnimationService) Do(ctx context.Context, opts ...RequestOption) (res *Message, err error) {
 	r := &request{
-		method:   http.MethodGet,
+		method:   http.MethodPost,
 		endpoint: "/sendAnimation",
 	}
 
 	r.setParam("chat_id", *t.chatID)
 	if t.animation != nil {
-		r.setParam("animation", *t.animation)
+		r.setFile("animation", *t.animation)
 	}
 	if t.animationString != nil {
 		r.setParam("animation", *t.animationString)
 	}
+	if t.thumbnail != nil {
+		r.setFile("thumbnail", *t.thumbnail)
+	}
+	if t.thumbnailString != nil {
+		r.setParam("thumbnail", *t.thumbnailString)
+	}
 	if t.duration != nil {
 		r.setParam("duration", *t.duration)
 	}
@@ -1592,22 +1544,7 @@ func (t *SendAnimationService) Do(ctx context.Context, opts ...RequestOption) (r
 	if err != nil {
 		return nil, err
 	}
-	data = common.ToJSONList(data)
-	if err != nil {
-		return nil, err
-	}
-
-	res = make([]*SendAnimation, 0)
-	err = json.Unmarshal(data, &res)
-	if err != nil {
-		return nil, err
-	}
-	return res, nil
-}
-
-type SendAnimation struct {
-	Ok     bool    `json:"ok"`
-	Result Message `json:"result"`
+	return decodeResult[*Message](data)
 }
 
 type SendVoiceService struct {
@@ -1640,6 +1577,11 @@ func (t *SendVoiceService) MessageThreadID(messageThreadID int64) *SendVoiceServ
 	return t
 }
 
+func (t *SendVoiceService) Caption(caption string) *SendVoiceService {
+	t.caption = &caption
+	return t
+}
+
 func (t *SendVoiceService) ParseMode(parseMode string) *SendVoiceService {
 	t.parseMode = &parseMode
 	return t
@@ -1741,15 +1683,15 @@ func (t *SendVoiceService) ForceReply(forceReply ForceReply) *SendVoiceService {
 	return t
 }
 
-func (t *SendVoiceService) Do(ctx context.Context, opts ...RequestOption) (res []*SendVoice, err error) {
+func (t *SendVoiceService) Do(ctx context.Context, opts ...RequestOption) (res *Message, err error) {
 	r := &request{
-		method:   http.MethodGet,
+		method:   http.MethodPost,
 		endpoint: "/sendVoice",
 	}
 
 	r.setParam("chat_id", *t.chatID)
 	if t.voice != nil {
-		r.setParam("voice", *t.voice)
+		r.setFile("voice", *t.voice)
 	}
 	if t.voiceString != nil {
 		r.setParam("voice", *t.voiceString)
@@ -1798,22 +1740,7 @@ func (t *SendVoiceService) Do(ctx context.Context, opts ...RequestOption) (res [
 	if err != nil {
 		return nil, err
 	}
-	data = common.ToJSONList(data)
-	if err != nil {
-		return nil, err
-	}
-
-	res = make([]*SendVoice, 0)
-	err = json.Unmarshal(data, &res)
-	if err != nil {
-		return nil, err
-	}
-	return res, nil
-}
-
-type SendVoice struct {
-	Ok     bool    `json:"ok"`
-	Result Message `json:"result"`
+	return decodeResult[*Message](data)
 }
 
 type SendVideoNoteService struct {
@@ -1846,6 +1773,16 @@ func (t *SendVideoNoteService) MessageThreadID(messageThreadID int64) *SendVideo
 	return t
 }
 
+func (t *SendVideoNoteService) VideoNote(videoNote InputFile) *SendVideoNoteService {
+	t.videoNote = &videoNote
+	return t
+}
+
+func (t *SendVideoNoteService) VideoNoteString(videoNoteString string) *SendVideoNoteService {
+	t.videoNoteString = &videoNoteString
+	return t
+}
+
 func (t *SendVideoNoteService) Animation(videoNote InputFile) *SendVideoNoteService {
 	t.videoNote = &videoNote
 	return t
@@ -1944,19 +1881,25 @@ func (t *SendVideoNoteService) ForceReply(forceReply ForceReply) *SendVideoNoteS
 	return t
 }
 
-func (t *SendVideoNoteService) Do(ctx context.Context, opts ...RequestOption) (res []*SendVideoNote, err error) {
+func (t *SendVideoNoteService) Do(ctx context.Context, opts ...RequestOption) (res *Message, err error) {
 	r := &request{
-		method:   http.MethodGet,
+		method:   http.MethodPost,
 		endpoint: "/sendVideoNote",
 	}
 
 	r.setParam("chat_id", *t.chatID)
 	if t.videoNote != nil {
-		r.setParam("video_note", *t.videoNote)
+		r.setFile("video_note", *t.videoNote)
 	}
 	if t.videoNoteString != nil {
 		r.setParam("video_note", *t.videoNoteString)
 	}
+	if t.thumbnail != nil {
+		r.setFile("thumbnail", *t.thumbnail)
+	}
+	if t.thumbnailString != nil {
+		r.setParam("thumbnail", *t.thumbnailString)
+	}
 	if t.duration != nil {
 		r.setParam("duration", *t.duration)
 	}
@@ -1995,32 +1938,14 @@ func (t *SendVideoNoteService) Do(ctx context.Context, opts ...RequestOption) (r
 	if err != nil {
 		return nil, err
 	}
-	data = common.ToJSONList(data)
-	if err != nil {
-		return nil, err
-	}
-
-	res = make([]*SendVideoNote, 0)
-	err = json.Unmarshal(data, &res)
-	if err != nil {
-		return nil, err
-	}
-	return res, nil
-}
-
-type SendVideoNote struct {
-	Ok     bool    `json:"ok"`
-	Result Message `json:"result"`
+	return decodeResult[*Message](data)
 }
 
 type SendMediaGroupService struct {
 	c                        *Client
 	chatID                   *int64
 	messageThreadID          *int64
-	inputMediaAudio          *string
-	inputMediaDocument       *string
-	inputMediaPhoto          *string
-	inputMediaVideo          *string
+	media                    []inputMedia
 	disableNotification      *bool
 	protectContent           *bool
 	replyToMessageID         *int64
@@ -2058,60 +1983,47 @@ func (t *SendMediaGroupService) AllowSendingWithoutReply(allowSendingWithoutRepl
 }
 
 func (t *SendMediaGroupService) InputMediaAudio(inputMediaAudio InputMediaAudio) *SendMediaGroupService {
-	json, err := jsoniter.Marshal(&inputMediaAudio)
-	if err != nil {
-		return nil
-	}
-
-	jsonString := string(json)
-
-	t.inputMediaAudio = &jsonString
+	t.media = append(t.media, inputMediaAudio)
 	return t
 }
 
 func (t *SendMediaGroupService) InputMediaDocument(inputMediaDocument InputMediaDocument) *SendMediaGroupService {
-	json, err := jsoniter.Marshal(&inputMediaDocument)
-	if err != nil {
-		return nil
-	}
-
-	jsonString := string(json)
-
-	t.inputMediaDocument = &jsonString
+	t.media = append(t.media, inputMediaDocument)
 	return t
 }
 
 func (t *SendMediaGroupService) InputMediaPhoto(inputMediaPhoto InputMediaPhoto) *SendMediaGroupService {
-	json, err := jsoniter.Marshal(&inputMediaPhoto)
-	if err != nil {
-		return nil
-	}
-
-	jsonString := string(json)
+	t.media = append(t.media, inputMediaPhoto)
+	return t
+}
 
-	t.inputMediaPhoto = &jsonString
+func (t *SendMediaGroupService) InputMediaVideo(inputMediaVideo InputMediaVideo) *SendMediaGroupService {
+	t.media = append(t.media, inputMediaVideo)
 	return t
 }
 
+// Deprecated: use InputMediaVideo.
 func (t *SendMediaGroupService) MediaInputMediaVideo(inputMediaVideo InputMediaVideo) *SendMediaGroupService {
-	json, err := jsoniter.Marshal(&inputMediaVideo)
-	if err != nil {
-		return nil
-	}
-
-	jsonString := string(json)
-
-	t.inputMediaVideo = &jsonString
-	return t
+	return t.InputMediaVideo(inputMediaVideo)
 }
 
-func (t *SendMediaGroupService) Do(ctx context.Context, opts ...RequestOption) (res []*SendMediaGroup, err error) {
+func (t *SendMediaGroupService) Do(ctx context.Context, opts ...RequestOption) (res []Message, err error) {
 	r := &request{
-		method:   http.MethodGet,
+		method:   http.MethodPost,
 		endpoint: "/sendMediaGroup",
 	}
 
+	err = validateMediaGroup(t.media)
+	if err != nil {
+		return nil, err
+	}
+	media, err := attachMedia(r, t.media)
+	if err != nil {
+		return nil, err
+	}
+
 	r.setParam("chat_id", *t.chatID)
+	r.setParam("media", media)
 	if t.messageThreadID != nil {
 		r.setParam("message_thread_id", *t.messageThreadID)
 	}
@@ -2127,39 +2039,102 @@ func (t *SendMediaGroupService) Do(ctx context.Context, opts ...RequestOption) (
 	if t.allowSendingWithoutReply != nil {
 		r.setParam("allow_sending_without_reply", *t.allowSendingWithoutReply)
 	}
-	if t.inputMediaAudio != nil {
-		r.setParam("media", *t.inputMediaAudio)
-	}
-	if t.inputMediaDocument != nil {
-		r.setParam("media", *t.inputMediaDocument)
-	}
-	if t.inputMediaPhoto != nil {
-		r.setParam("media", *t.inputMediaPhoto)
-	}
-	if t.inputMediaVideo != nil {
-		r.setParam("media", *t.inputMediaVideo)
-	}
 
 	data, err := t.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return nil, err
 	}
-	data = common.ToJSONList(data)
-	if err != nil {
-		return nil, err
-	}
+	return decodeResult[[]Message](data)
+}
 
-	res = make([]*SendMediaGroup, 0)
-	err = json.Unmarshal(data, &res)
-	if err != nil {
-		return nil, err
+type inputMedia interface {
+	mediaType() string
+	mediaFiles() (media InputFile, thumbnail InputFile)
+}
+
+func (m InputMediaPhoto) mediaType() string {
+	return "photo"
+}
+
+func (m InputMediaPhoto) mediaFiles() (InputFile, InputFile) {
+	return m.MediaFile, InputFile{}
+}
+
+func (m InputMediaVideo) mediaType() string {
+	return "video"
+}
+
+func (m InputMediaVideo) mediaFiles() (InputFile, InputFile) {
+	return m.MediaFile, m.Thumbnail
+}
+
+func (m InputMediaAudio) mediaType() string {
+	return "audio"
+}
+
+func (m InputMediaAudio) mediaFiles() (InputFile, InputFile) {
+	return m.MediaFile, m.Thumbnail
+}
+
+func (m InputMediaDocument) mediaType() string {
+	return "document"
+}
+
+func (m InputMediaDocument) mediaFiles() (InputFile, InputFile) {
+	return m.MediaFile, m.Thumbnail
+}
+
+// validateMediaGroup applies Telegram's album rules: 2-10 items, where audio
+// and documents can only be grouped with items of the same type.
+func validateMediaGroup(media []inputMedia) error {
+	if len(media) < 2 || len(media) > 10 {
+		return fmt.Errorf("media group must contain 2-10 items, got %d", len(media))
+	}
+	for _, m := range media[1:] {
+		first, current := media[0].mediaType(), m.mediaType()
+		if first == current {
+			continue
+		}
+		if first == "audio" || first == "document" || current == "audio" || current == "document" {
+			return fmt.Errorf("media group cannot mix %s and %s items", first, current)
+		}
 	}
-	return res, nil
+	return nil
 }
 
-type SendMediaGroup struct {
-	Ok     bool    `json:"ok"`
-	Result Message `json:"result"`
+// attachMedia converts media to the JSON objects sent in the media parameter.
+// Local files are added to r as multipart parts and referenced from the JSON
+// with attach://<name>.
+func attachMedia(r *request, media []inputMedia) ([]params, error) {
+	items := make([]params, 0, len(media))
+	for i, m := range media {
+		data, err := json.Marshal(m)
+		if err != nil {
+			return nil, err
+		}
+		item := params{}
+		err = json.Unmarshal(data, &item)
+		if err != nil {
+			return nil, err
+		}
+		if item["type"] == "" {
+			item["type"] = m.mediaType()
+		}
+
+		file, thumbnail := m.mediaFiles()
+		if file.isSet() {
+			name := fmt.Sprintf("media%d", i)
+			r.setFile(name, file)
+			item["media"] = "attach://" + name
+		}
+		if thumbnail.isSet() {
+			name := fmt.Sprintf("thumbnail%d", i)
+			r.setFile(name, thumbnail)
+			item["thumbnail"] = "attach://" + name
+		}
+		items = append(items, item)
+	}
+	return items, nil
 }
 
 type SendLocationService struct {
@@ -2290,9 +2265,9 @@ func (t *SendLocationService) ForceReply(forceReply ForceReply) *SendLocationSer
 	return t
 }
 
-func (t *SendLocationService) Do(ctx context.Context, opts ...RequestOption) (res []*SendLocation, err error) {
+func (t *SendLocationService) Do(ctx context.Context, opts ...RequestOption) (res *Message, err error) {
 	r := &request{
-		method:   http.MethodGet,
+		method:   http.MethodPost,
 		endpoint: "/sendLocation",
 	}
 
@@ -2343,22 +2318,7 @@ func (t *SendLocationService) Do(ctx context.Context, opts ...RequestOption) (re
 	if err != nil {
 		return nil, err
 	}
-	data = common.ToJSONList(data)
-	if err != nil {
-		return nil, err
-	}
-
-	res = make([]*SendLocation, 0)
-	err = json.Unmarshal(data, &res)
-	if err != nil {
-		return nil, err
-	}
-	return res, nil
-}
-
-type SendLocation struct {
-	Ok     bool    `json:"ok"`
-	Result Message `json:"result"`
+	return decodeResult[*Message](data)
 }
 
 type SendVenueService struct {
@@ -2501,9 +2461,9 @@ func (t *SendVenueService) ForceReply(forceReply ForceReply) *SendVenueService {
 	return t
 }
 
-func (t *SendVenueService) Do(ctx context.Context, opts ...RequestOption) (res []*SendVenue, err error) {
+func (t *SendVenueService) Do(ctx context.Context, opts ...RequestOption) (res *Message, err error) {
 	r := &request{
-		method:   http.MethodGet,
+		method:   http.MethodPost,
 		endpoint: "/sendVenue",
 	}
 
@@ -2556,22 +2516,7 @@ func (t *SendVenueService) Do(ctx context.Context, opts ...RequestOption) (res [
 	if err != nil {
 		return nil, err
 	}
-	data = common.ToJSONList(data)
-	if err != nil {
-		return nil, err
-	}
-
-	res = make([]*SendVenue, 0)
-	err = json.Unmarshal(data, &res)
-	if err != nil {
-		return nil, err
-	}
-	return res, nil
-}
-
-type SendVenue struct {
-	Ok     bool    `json:"ok"`
-	Result Message `json:"result"`
+	return decodeResult[*Message](data)
 }
 
 type SendContactService struct {
@@ -2690,9 +2635,9 @@ func (t *SendContactService) ForceReply(forceReply ForceReply) *SendContactServi
 	return t
 }
 
-func (t *SendContactService) Do(ctx context.Context, opts ...RequestOption) (res []*SendContact, err error) {
+func (t *SendContactService) Do(ctx context.Context, opts ...RequestOption) (res *Message, err error) {
 	r := &request{
-		method:   http.MethodGet,
+		method:   http.MethodPost,
 		endpoint: "/sendContact",
 	}
 
@@ -2737,22 +2682,7 @@ func (t *SendContactService) Do(ctx context.Context, opts ...RequestOption) (res
 	if err != nil {
 		return nil, err
 	}
-	data = common.ToJSONList(data)
-	if err != nil {
-		return nil, err
-	}
-
-	res = make([]*SendContact, 0)
-	err = json.Unmarshal(data, &res)
-	if err != nil {
-		return nil, err
-	}
-	return res, nil
-}
-
-type SendContact struct {
-	Ok     bool    `json:"ok"`
-	Result Message `json:"result"`
+	return decodeResult[*Message](data)
 }
 
 type SendPollService struct {
@@ -2940,9 +2870,9 @@ func (t *SendPollService) ForceReply(forceReply ForceReply) *SendPollService {
 	return t
 }
 
-func (t *SendPollService) Do(ctx context.Context, opts ...RequestOption) (res []*SendPoll, err error) {
+func (t *SendPollService) Do(ctx context.Context, opts ...RequestOption) (res *Message, err error) {
 	r := &request{
-		method:   http.MethodGet,
+		method:   http.MethodPost,
 		endpoint: "/sendPoll",
 	}
 
@@ -3011,22 +2941,7 @@ func (t *SendPollService) Do(ctx context.Context, opts ...RequestOption) (res []
 	if err != nil {
 		return nil, err
 	}
-	data = common.ToJSONList(data)
-	if err != nil {
-		return nil, err
-	}
-
-	res = make([]*SendPoll, 0)
-	err = json.Unmarshal(data, &res)
-	if err != nil {
-		return nil, err
-	}
-	return res, nil
-}
-
-type SendPoll struct {
-	Ok     bool    `json:"ok"`
-	Result Message `json:"result"`
+	return decodeResult[*Message](data)
 }
 
 type SendDiceService struct {
@@ -3127,9 +3042,9 @@ func (t *SendDiceService) ForceReply(forceReply ForceReply) *SendDiceService {
 	return t
 }
 
-func (t *SendDiceService) Do(ctx context.Context, opts ...RequestOption) (res []*SendDice, err error) {
+func (t *SendDiceService) Do(ctx context.Context, opts ...RequestOption) (res *Message, err error) {
 	r := &request{
-		method:   http.MethodGet,
+		method:   http.MethodPost,
 		endpoint: "/sendDice",
 	}
 
@@ -3169,22 +3084,7 @@ func (t *SendDiceService) Do(ctx context.Context, opts ...RequestOption) (res []
 	if err != nil {
 		return nil, err
 	}
-	data = common.ToJSONList(data)
-	if err != nil {
-		return nil, err
-	}
-
-	res = make([]*SendDice, 0)
-	err = json.Unmarshal(data, &res)
-	if err != nil {
-		return nil, err
-	}
-	return res, nil
-}
-
-type SendDice struct {
-	Ok     bool    `json:"ok"`
-	Result Message `json:"result"`
+	return decodeResult[*Message](data)
 }
 
 type SendChatActionService struct {
@@ -3209,9 +3109,9 @@ func (t *SendChatActionService) Action(action string) *SendChatActionService {
 	return t
 }
 
-func (t *SendChatActionService) Do(ctx context.Context, opts ...RequestOption) (res []*SendChatAction, err error) {
+func (t *SendChatActionService) Do(ctx context.Context, opts ...RequestOption) (res bool, err error) {
 	r := &request{
-		method:   http.MethodGet,
+		method:   http.MethodPost,
 		endpoint: "/sendChatAction",
 	}
 
@@ -3223,22 +3123,7 @@ func (t *SendChatActionService) Do(ctx context.Context, opts ...RequestOption) (
 
 	data, err := t.c.callAPI(ctx, r, opts...)
 	if err != nil {
-		return nil, err
-	}
-	data = common.ToJSONList(data)
-	if err != nil {
-		return nil, err
-	}
-
-	res = make([]*SendChatAction, 0)
-	err = json.Unmarshal(data, &res)
-	if err != nil {
-		return nil, err
+		return false, err
 	}
-	return res, nil
-}
-
-type SendChatAction struct {
-	Ok     bool    `json:"ok"`
-	Result Message `json:"result"`
+	return decodeResult[bool](data)
 }
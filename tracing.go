@@ -0,0 +1,65 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"telegram/common"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+const tracerName = "telegram"
+
+func (c *Client) tracer() trace.Tracer {
+	if c.TracerProvider == nil {
+		return noop.NewTracerProvider().Tracer(tracerName)
+	}
+	return c.TracerProvider.Tracer(tracerName)
+}
+
+// startCallSpan starts the span that covers a call, including its
+// middleware, retries and migration.
+func (c *Client) startCallSpan(ctx context.Context, r *request) (context.Context, trace.Span) {
+	attrs := []attribute.KeyValue{attribute.String("telegram.method", r.apiMethod())}
+	if chatID := r.chatID(); chatID != "" {
+		attrs = append(attrs, attribute.String("telegram.chat_id", chatID))
+	}
+	return c.tracer().Start(ctx, "telegram."+r.apiMethod(),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+}
+
+func (c *Client) startUpdateSpan(ctx context.Context, update *Update) (context.Context, trace.Span) {
+	attrs := []attribute.KeyValue{
+		attribute.Int64("telegram.update_id", update.UpdateID),
+		attribute.String("telegram.update_type", update.Type()),
+	}
+	if chatID := update.EffectiveChatID(); chatID != 0 {
+		attrs = append(attrs, attribute.String("telegram.chat_id", strconv.FormatInt(chatID, 10)))
+	}
+	return c.tracer().Start(ctx, "telegram.update",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(attrs...),
+	)
+}
+
+func endSpan(span trace.Span, err error) {
+	defer span.End()
+
+	if err == nil {
+		return
+	}
+	apiErr := new(common.APIError)
+	if errors.As(err, &apiErr) {
+		span.SetAttributes(attribute.Int("telegram.error_code", apiErr.ErrorCode))
+		span.SetStatus(codes.Error, apiErr.Description)
+	} else {
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.RecordError(err)
+}
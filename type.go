@@ -1,5 +1,7 @@
 package telegram
 
+import "telegram/common"
+
 type User struct {
 	ID                      int64  `json:"id"`
 	IsBot                   bool   `json:"is_bot"`
@@ -15,104 +17,104 @@ type User struct {
 }
 
 type Chat struct {
-	ID                                 int64
-	ChatType                           string
-	Title                              string
-	Username                           string
-	FirstName                          string
-	LastName                           string
-	IsForum                            bool
-	Photo                              ChatPhoto
-	ActiveUsernames                    []string
-	EmojiStatusCustomEmojiID           string
-	EmojiStatusExpirationDate          int64
-	Bio                                string
-	HasPrivateForwards                 bool
-	HasRestrictedVoiceAndVideoMessages bool
-	JoinToSendMessages                 bool
-	JoinByRequest                      bool
-	Description                        string
-	InviteLink                         string
-	PinnedMessage                      chatMessage
-	Permissions                        ChatPermissions
-	SlowModeDelay                      int64
-	MessageAutoDeleteTime              int64
-	HasAggressiveAntiSpamEnabled       bool
-	HasHiddenMembers                   bool
-	HasProtectedContent                bool
-	StickerSetName                     string
-	CanSetStickerSet                   bool
-	LinkedChatID                       int64
-	Location                           ChatLocation
+	ID                                 int64           `json:"id"`
+	ChatType                           string          `json:"type"`
+	Title                              string          `json:"title"`
+	Username                           string          `json:"username"`
+	FirstName                          string          `json:"first_name"`
+	LastName                           string          `json:"last_name"`
+	IsForum                            bool            `json:"is_forum"`
+	Photo                              ChatPhoto       `json:"photo"`
+	ActiveUsernames                    []string        `json:"active_usernames"`
+	EmojiStatusCustomEmojiID           string          `json:"emoji_status_custom_emoji_id"`
+	EmojiStatusExpirationDate          int64           `json:"emoji_status_expiration_date"`
+	Bio                                string          `json:"bio"`
+	HasPrivateForwards                 bool            `json:"has_private_forwards"`
+	HasRestrictedVoiceAndVideoMessages bool            `json:"has_restricted_voice_and_video_messages"`
+	JoinToSendMessages                 bool            `json:"join_to_send_messages"`
+	JoinByRequest                      bool            `json:"join_by_request"`
+	Description                        string          `json:"description"`
+	InviteLink                         string          `json:"invite_link"`
+	PinnedMessage                      chatMessage     `json:"pinned_message"`
+	Permissions                        ChatPermissions `json:"permissions"`
+	SlowModeDelay                      int64           `json:"slow_mode_delay"`
+	MessageAutoDeleteTime              int64           `json:"message_auto_delete_time"`
+	HasAggressiveAntiSpamEnabled       bool            `json:"has_aggressive_anti_spam_enabled"`
+	HasHiddenMembers                   bool            `json:"has_hidden_members"`
+	HasProtectedContent                bool            `json:"has_protected_content"`
+	StickerSetName                     string          `json:"sticker_set_name"`
+	CanSetStickerSet                   bool            `json:"can_set_sticker_set"`
+	LinkedChatID                       int64           `json:"linked_chat_id"`
+	Location                           ChatLocation    `json:"location"`
 }
 
 type chatMessage struct {
-	MessageID                     int64
-	MessageThreadID               int64
-	From                          User
-	Date                          int64
-	ForwardFrom                   User
-	ForwardFromMessageID          int64
-	ForwardSignature              string
-	ForwardSenderName             string
-	ForwardDate                   int64
-	IsTopicMessage                bool
-	IsAutomaticForward            bool
-	ViaBot                        User
-	EditDate                      int64
-	HasProtectedContent           bool
-	MediaGroupID                  string
-	AuthorSignature               string
-	Text                          string
-	Entities                      []MessageEntity
-	Animation                     Animation
-	Audio                         Audio
-	Document                      Document
-	Photo                         []PhotoSize
-	Sticker                       Sticker
-	Story                         Story
-	Video                         Video
-	VideoNote                     VideoNote
-	Voice                         Voice
-	Caption                       string
-	CaptionEntities               []MessageEntity
-	HasMediaSpoiler               bool
-	Contact                       Contact
-	Dice                          Dice
-	Game                          Game
-	Poll                          Poll
-	Venue                         Venue
-	Location                      Location
-	NewChatMembers                []User
-	LeftChatMember                User
-	NewChatPhoto                  []PhotoSize
-	DeleteChatPhoto               bool
-	GroupChatCreated              bool
-	SupergroupChatCreated         bool
-	ChannelChatCreated            bool
-	MessageAutoDeleteTimerChanged MessageAutoDeleteTimerChanged
-	MigrateToChatID               int64
-	MigrateFromChatID             int64
-	Invoice                       Invoice
-	SuccessfulPayment             SuccessfulPayment
-	UserShared                    UserShared
-	ChatShared                    ChatShared
-	ConnectedWebsite              string
-	WriteAccessAllowed            WriteAccessAllowed
-	PassportData                  PassportData
-	ProximityAlertTriggered       ProximityAlertTriggered
-	ForumTopicCreated             ForumTopicCreated
-	ForumTopicEdited              ForumTopicEdited
-	ForumTopicClosed              ForumTopicClosed
-	ForumTopicReopened            ForumTopicReopened
-	GeneralForumTopicHidden       GeneralForumTopicHidden
-	GeneralForumTopicUnhidden     GeneralForumTopicUnhidden
-	VideoChatScheduled            VideoChatScheduled
-	VideoChatStarted              VideoChatStarted
-	VideoChatEnded                VideoChatEnded
-	VideoChatParticipantsInvited  VideoChatParticipantsInvited
-	WebAppData                    WebAppData
-	InlineKeyboardMarkup          InlineKeyboardMarkup
+	MessageID                     int64                         `json:"message_id"`
+	MessageThreadID               int64                         `json:"message_thread_id"`
+	From                          User                          `json:"from"`
+	Date                          int64                         `json:"date"`
+	ForwardFrom                   User                          `json:"forward_from"`
+	ForwardFromMessageID          int64                         `json:"forward_from_message_id"`
+	ForwardSignature              string                        `json:"forward_signature"`
+	ForwardSenderName             string                        `json:"forward_sender_name"`
+	ForwardDate                   int64                         `json:"forward_date"`
+	IsTopicMessage                bool                          `json:"is_topic_message"`
+	IsAutomaticForward            bool                          `json:"is_automatic_forward"`
+	ViaBot                        User                          `json:"via_bot"`
+	EditDate                      int64                         `json:"edit_date"`
+	HasProtectedContent           bool                          `json:"has_protected_content"`
+	MediaGroupID                  string                        `json:"media_group_id"`
+	AuthorSignature               string                        `json:"author_signature"`
+	Text                          string                        `json:"text"`
+	Entities                      []MessageEntity               `json:"entities"`
+	Animation                     Animation                     `json:"animation"`
+	Audio                         Audio                         `json:"audio"`
+	Document                      Document                      `json:"document"`
+	Photo                         []PhotoSize                   `json:"photo"`
+	Sticker                       Sticker                       `json:"sticker"`
+	Story                         Story                         `json:"story"`
+	Video                         Video                         `json:"video"`
+	VideoNote                     VideoNote                     `json:"video_note"`
+	Voice                         Voice                         `json:"voice"`
+	Caption                       string                        `json:"caption"`
+	CaptionEntities               []MessageEntity               `json:"caption_entities"`
+	HasMediaSpoiler               bool                          `json:"has_media_spoiler"`
+	Contact                       Contact                       `json:"contact"`
+	Dice                          Dice                          `json:"dice"`
+	Game                          Game                          `json:"game"`
+	Poll                          Poll                          `json:"poll"`
+	Venue                         Venue                         `json:"venue"`
+	Location                      Location                      `json:"location"`
+	NewChatMembers                []User                        `json:"new_chat_members"`
+	LeftChatMember                User                          `json:"left_chat_member"`
+	NewChatPhoto                  []PhotoSize                   `json:"new_chat_photo"`
+	DeleteChatPhoto               bool                          `json:"delete_chat_photo"`
+	GroupChatCreated              bool                          `json:"group_chat_created"`
+	SupergroupChatCreated         bool                          `json:"supergroup_chat_created"`
+	ChannelChatCreated            bool                          `json:"channel_chat_created"`
+	MessageAutoDeleteTimerChanged MessageAutoDeleteTimerChanged `json:"message_auto_delete_timer_changed"`
+	MigrateToChatID               int64                         `json:"migrate_to_chat_id"`
+	MigrateFromChatID             int64                         `json:"migrate_from_chat_id"`
+	Invoice                       Invoice                       `json:"invoice"`
+	SuccessfulPayment             SuccessfulPayment             `json:"successful_payment"`
+	UserShared                    UserShared                    `json:"user_shared"`
+	ChatShared                    ChatShared                    `json:"chat_shared"`
+	ConnectedWebsite              string                        `json:"connected_website"`
+	WriteAccessAllowed            WriteAccessAllowed            `json:"write_access_allowed"`
+	PassportData                  PassportData                  `json:"passport_data"`
+	ProximityAlertTriggered       ProximityAlertTriggered       `json:"proximity_alert_triggered"`
+	ForumTopicCreated             ForumTopicCreated             `json:"forum_topic_created"`
+	ForumTopicEdited              ForumTopicEdited              `json:"forum_topic_edited"`
+	ForumTopicClosed              ForumTopicClosed              `json:"forum_topic_closed"`
+	ForumTopicReopened            ForumTopicReopened            `json:"forum_topic_reopened"`
+	GeneralForumTopicHidden       GeneralForumTopicHidden       `json:"general_forum_topic_hidden"`
+	GeneralForumTopicUnhidden     GeneralForumTopicUnhidden     `json:"general_forum_topic_unhidden"`
+	VideoChatScheduled            VideoChatScheduled            `json:"video_chat_scheduled"`
+	VideoChatStarted              VideoChatStarted              `json:"video_chat_started"`
+	VideoChatEnded                VideoChatEnded                `json:"video_chat_ended"`
+	VideoChatParticipantsInvited  VideoChatParticipantsInvited  `json:"video_chat_participants_invited"`
+	WebAppData                    WebAppData                    `json:"web_app_data"`
+	InlineKeyboardMarkup          InlineKeyboardMarkup          `json:"reply_markup"`
 }
 
 type Message struct {
@@ -186,7 +188,7 @@ type Message struct {
 	VideoChatEnded                VideoChatEnded                `json:"video_chat_ended"`
 	VideoChatParticipantsInvited  VideoChatParticipantsInvited  `json:"video_chat_participants_invited"`
 	WebAppData                    WebAppData                    `json:"web_app_data"`
-	InlineKeyboardMarkup          InlineKeyboardMarkup          `json:"inline_keyboard_markup"`
+	InlineKeyboardMarkup          InlineKeyboardMarkup          `json:"reply_markup"`
 }
 
 type messageChat struct {
@@ -220,197 +222,219 @@ type messageChat struct {
 }
 
 type ChatPhoto struct {
-	SmallFileID       string
-	SmallFileUniqueID string
-	BigFileID         string
-	BigFileUniqueID   string
+	SmallFileID       string `json:"small_file_id"`
+	SmallFileUniqueID string `json:"small_file_unique_id"`
+	BigFileID         string `json:"big_file_id"`
+	BigFileUniqueID   string `json:"big_file_unique_id"`
 }
 
 type ChatInviteLink struct {
-	InviteLink              string
-	Creator                 User
-	CreatesJoinRequest      bool
-	IsPrimary               bool
-	IsRevoked               bool
-	Name                    string
-	ExpireDate              int64
-	MemberLimit             int64
-	PendingJoinRequestCount int64
+	InviteLink              string `json:"invite_link"`
+	Creator                 User   `json:"creator"`
+	CreatesJoinRequest      bool   `json:"creates_join_request"`
+	IsPrimary               bool   `json:"is_primary"`
+	IsRevoked               bool   `json:"is_revoked"`
+	Name                    string `json:"name"`
+	ExpireDate              int64  `json:"expire_date"`
+	MemberLimit             int64  `json:"member_limit"`
+	PendingJoinRequestCount int64  `json:"pending_join_request_count"`
 }
 
 type ChatPermissions struct {
-	CanSendMessages       bool
-	CanSendAudios         bool
-	CanSendDocuments      bool
-	CanSendPhotos         bool
-	CanSendVideos         bool
-	CanSendVideoNotes     bool
-	CanSendVoiceNotes     bool
-	CanSendPolls          bool
-	CanSendOtherMessages  bool
-	CanAddWebPagePreviews bool
-	CanChangeInfo         bool
-	CanInviteUsers        bool
-	CanPinMessages        bool
-	CanManageTopics       bool
+	CanSendMessages       bool `json:"can_send_messages"`
+	CanSendAudios         bool `json:"can_send_audios"`
+	CanSendDocuments      bool `json:"can_send_documents"`
+	CanSendPhotos         bool `json:"can_send_photos"`
+	CanSendVideos         bool `json:"can_send_videos"`
+	CanSendVideoNotes     bool `json:"can_send_video_notes"`
+	CanSendVoiceNotes     bool `json:"can_send_voice_notes"`
+	CanSendPolls          bool `json:"can_send_polls"`
+	CanSendOtherMessages  bool `json:"can_send_other_messages"`
+	CanAddWebPagePreviews bool `json:"can_add_web_page_previews"`
+	CanChangeInfo         bool `json:"can_change_info"`
+	CanInviteUsers        bool `json:"can_invite_users"`
+	CanPinMessages        bool `json:"can_pin_messages"`
+	CanManageTopics       bool `json:"can_manage_topics"`
 }
 
 type ChatLocation struct {
-	Location Location
-	Address  string
+	Location Location `json:"location"`
+	Address  string   `json:"address"`
 }
 
 type MessageEntity struct {
-	MessageEntityType string
-	Offset            int64
-	Length            int64
-	URL               string
-	User              User
-	Language          string
-	CustomEmojiID     string
+	MessageEntityType string `json:"type"`
+	Offset            int64  `json:"offset"`
+	Length            int64  `json:"length"`
+	URL               string `json:"url,omitempty"`
+	User              *User  `json:"user,omitempty"`
+	Language          string `json:"language,omitempty"`
+	CustomEmojiID     string `json:"custom_emoji_id,omitempty"`
 }
 
 type Animation struct {
-	FileID       string
-	FileUniqueID string
-	Width        int64
-	Height       int64
-	Duration     int64
-	Thumbnail    PhotoSize
-	FileName     string
-	MimeType     string
-	FileSize     int64
+	FileID       string    `json:"file_id"`
+	FileUniqueID string    `json:"file_unique_id"`
+	Width        int64     `json:"width"`
+	Height       int64     `json:"height"`
+	Duration     int64     `json:"duration"`
+	Thumbnail    PhotoSize `json:"thumbnail"`
+	FileName     string    `json:"file_name"`
+	MimeType     string    `json:"mime_type"`
+	FileSize     int64     `json:"file_size"`
 }
 
 type Audio struct {
-	FileID       string
-	FileUniqueID string
-	Duration     int64
-	Performer    string
-	Title        string
-	FileName     string
-	MimeType     string
-	FileSize     int64
-	Thumbnail    PhotoSize
+	FileID       string    `json:"file_id"`
+	FileUniqueID string    `json:"file_unique_id"`
+	Duration     int64     `json:"duration"`
+	Performer    string    `json:"performer"`
+	Title        string    `json:"title"`
+	FileName     string    `json:"file_name"`
+	MimeType     string    `json:"mime_type"`
+	FileSize     int64     `json:"file_size"`
+	Thumbnail    PhotoSize `json:"thumbnail"`
 }
 
 type Document struct {
-	FileID       string
-	FileUniqueID string
-	Thumbnail    PhotoSize
-	FileName     string
-	MimeType     string
-	FileSize     int64
+	FileID       string    `json:"file_id"`
+	FileUniqueID string    `json:"file_unique_id"`
+	Thumbnail    PhotoSize `json:"thumbnail"`
+	FileName     string    `json:"file_name"`
+	MimeType     string    `json:"mime_type"`
+	FileSize     int64     `json:"file_size"`
 }
 
 type PhotoSize struct {
-	FileID       string
-	FileUniqueID string
-	Width        int64
-	Height       int64
-	FileSize     int64
+	FileID       string `json:"file_id"`
+	FileUniqueID string `json:"file_unique_id"`
+	Width        int64  `json:"width"`
+	Height       int64  `json:"height"`
+	FileSize     int64  `json:"file_size"`
 }
 
 type Sticker struct {
+	FileID           string       `json:"file_id"`
+	FileUniqueID     string       `json:"file_unique_id"`
+	Type             string       `json:"type"`
+	Width            int64        `json:"width"`
+	Height           int64        `json:"height"`
+	IsAnimated       bool         `json:"is_animated"`
+	IsVideo          bool         `json:"is_video"`
+	Thumbnail        PhotoSize    `json:"thumbnail"`
+	Emoji            string       `json:"emoji"`
+	SetName          string       `json:"set_name"`
+	PremiumAnimation File         `json:"premium_animation"`
+	MaskPosition     MaskPosition `json:"mask_position"`
+	CustomEmojiID    string       `json:"custom_emoji_id"`
+	NeedsRepainting  bool         `json:"needs_repainting"`
+	FileSize         int64        `json:"file_size"`
+}
+
+type MaskPosition struct {
+	Point  string  `json:"point"`
+	XShift float64 `json:"x_shift"`
+	YShift float64 `json:"y_shift"`
+	Scale  float64 `json:"scale"`
 }
 
 type Story struct {
 }
 
 type Video struct {
-	FileID       string
-	FileUniqueID string
-	Width        int64
-	Height       int64
-	Duration     int64
-	Thumbnail    PhotoSize
-	FileName     string
-	MimeType     string
-	FileSize     int64
+	FileID       string    `json:"file_id"`
+	FileUniqueID string    `json:"file_unique_id"`
+	Width        int64     `json:"width"`
+	Height       int64     `json:"height"`
+	Duration     int64     `json:"duration"`
+	Thumbnail    PhotoSize `json:"thumbnail"`
+	FileName     string    `json:"file_name"`
+	MimeType     string    `json:"mime_type"`
+	FileSize     int64     `json:"file_size"`
 }
 
 type VideoNote struct {
-	FileID       string
-	FileUniqueID string
-	Length       int64
-	Duration     int64
-	Thumbnail    PhotoSize
-	FileSize     int64
+	FileID       string    `json:"file_id"`
+	FileUniqueID string    `json:"file_unique_id"`
+	Length       int64     `json:"length"`
+	Duration     int64     `json:"duration"`
+	Thumbnail    PhotoSize `json:"thumbnail"`
+	FileSize     int64     `json:"file_size"`
 }
 
 type Voice struct {
-	FileID       string
-	FileUniqueID string
-	Duration     int64
-	MimeType     string
-	FileSize     int64
+	FileID       string `json:"file_id"`
+	FileUniqueID string `json:"file_unique_id"`
+	Duration     int64  `json:"duration"`
+	MimeType     string `json:"mime_type"`
+	FileSize     int64  `json:"file_size"`
 }
 
 type Contact struct {
-	PhoneNumber string
-	FirstName   string
-	LastName    string
-	UserIDD     int64
-	Vcard       string
+	PhoneNumber string `json:"phone_number"`
+	FirstName   string `json:"first_name"`
+	LastName    string `json:"last_name"`
+	UserIDD     int64  `json:"user_id"`
+	Vcard       string `json:"vcard"`
 }
 
 type Dice struct {
-	Emoji string
-	Value int64
+	Emoji string `json:"emoji"`
+	Value int64  `json:"value"`
 }
 
 type Game struct {
 }
 
 type Poll struct {
-	ID                    string
-	Question              string
-	Options               []PollOption
-	TotalVoterCount       int64
-	IsClosed              bool
-	IsAnonymous           bool
-	PollType              PollType
-	AllowsMultipleAnswers bool
-	CorrectOptionID       int64
-	Explanation           string
-	ExplanationEntities   []MessageEntity
-	OpenPeriod            int64
-	CloseDate             int64
+	ID                    string          `json:"id"`
+	Question              string          `json:"question"`
+	Options               []PollOption    `json:"options"`
+	TotalVoterCount       int64           `json:"total_voter_count"`
+	IsClosed              bool            `json:"is_closed"`
+	IsAnonymous           bool            `json:"is_anonymous"`
+	PollType              PollType        `json:"type"`
+	AllowsMultipleAnswers bool            `json:"allows_multiple_answers"`
+	CorrectOptionID       int64           `json:"correct_option_id"`
+	Explanation           string          `json:"explanation"`
+	ExplanationEntities   []MessageEntity `json:"explanation_entities"`
+	OpenPeriod            int64           `json:"open_period"`
+	CloseDate             int64           `json:"close_date"`
 }
 
 type PollOption struct {
-	Text       string
-	VoterCount int64
+	Text       string `json:"text"`
+	VoterCount int64  `json:"voter_count"`
 }
 
 type PollAnswer struct {
-	PollID    string
-	VoterChat Chat
-	User      User
-	OptionIDs []int64
+	PollID    string  `json:"poll_id"`
+	VoterChat Chat    `json:"voter_chat"`
+	User      User    `json:"user"`
+	OptionIDs []int64 `json:"option_ids"`
 }
 
 type Venue struct {
-	Location        Location
-	Title           string
-	Address         string
-	FoursquareID    string
-	FoursquareType  string
-	GooglePlaceID   string
-	GooglePlaceType string
+	Location        Location `json:"location"`
+	Title           string   `json:"title"`
+	Address         string   `json:"address"`
+	FoursquareID    string   `json:"foursquare_id"`
+	FoursquareType  string   `json:"foursquare_type"`
+	GooglePlaceID   string   `json:"google_place_id"`
+	GooglePlaceType string   `json:"google_place_type"`
 }
 
 type Location struct {
-	Longitude            float64
-	Latitude             float64
-	HorizontalAccuracy   float64
-	LivePeriod           int64
-	Heading              int64
-	ProximityAlertRadius int64
+	Longitude            float64 `json:"longitude"`
+	Latitude             float64 `json:"latitude"`
+	HorizontalAccuracy   float64 `json:"horizontal_accuracy"`
+	LivePeriod           int64   `json:"live_period"`
+	Heading              int64   `json:"heading"`
+	ProximityAlertRadius int64   `json:"proximity_alert_radius"`
 }
 
 type MessageAutoDeleteTimerChanged struct {
-	MessageAutoDeleteTime int64
+	MessageAutoDeleteTime int64 `json:"message_auto_delete_time"`
 }
 
 type Invoice struct {
@@ -420,114 +444,111 @@ type SuccessfulPayment struct {
 }
 
 type UserShared struct {
-	RequestID int64
-	UserID    int64
+	RequestID int64 `json:"request_id"`
+	UserID    int64 `json:"user_id"`
 }
 
 type ChatShared struct {
-	RequestID int64
-	ChatID    int64
+	RequestID int64 `json:"request_id"`
+	ChatID    int64 `json:"chat_id"`
 }
 
 type WriteAccessAllowed struct {
-	FromRequest        bool
-	WebAppName         string
-	FromAttachmentMenu bool
+	FromRequest        bool   `json:"from_request"`
+	WebAppName         string `json:"web_app_name"`
+	FromAttachmentMenu bool   `json:"from_attachment_menu"`
 }
 
 type PassportData struct {
 }
 
 type BotCommand struct {
-	Command     string
-	Description string
+	Command     string `json:"command"`
+	Description string `json:"description"`
 }
 
 type BotCommandScopeDefault struct {
-	BotCommandScopeDefaultType string
+	BotCommandScopeDefaultType string `json:"type"`
 }
 
 type BotCommandScopeAllPrivateChats struct {
-	BotCommandScopeAllPrivateChatsType string
+	BotCommandScopeAllPrivateChatsType string `json:"type"`
 }
 
 type BotCommandScopeAllGroupChats struct {
-	BotCommandScopeAllGroupChatsType string
+	BotCommandScopeAllGroupChatsType string `json:"type"`
 }
 
 type BotCommandScopeAllChatAdministrators struct {
-	BotCommandScopeAllChatAdministratorsType string
+	BotCommandScopeAllChatAdministratorsType string `json:"type"`
 }
 
 type BotCommandScopeChat struct {
-	BotCommandScopeChatType string
-	ChatID                  string
+	BotCommandScopeChatType string `json:"type"`
+	ChatID                  string `json:"chat_id"`
 }
 
 type BotCommandScopeChatAdministrators struct {
-	BotCommandScopeChatAdministratorsType string
-	ChatID                                string
+	BotCommandScopeChatAdministratorsType string `json:"type"`
+	ChatID                                string `json:"chat_id"`
 }
 
 type BotCommandScopeChatMember struct {
-	BotCommandScopeChatMemberType string
-	ChatID                        string
-	UserID                        int64
+	BotCommandScopeChatMemberType string `json:"type"`
+	ChatID                        string `json:"chat_id"`
+	UserID                        int64  `json:"user_id"`
 }
 
 type BotName struct {
-	Name string
+	Name string `json:"name"`
 }
 
 type BotDescription struct {
-	Description string
+	Description string `json:"description"`
 }
 
 type BotShortDescription struct {
-	ShortDescription string
+	ShortDescription string `json:"short_description"`
 }
 
 type MenuButtonCommands struct {
-	MenuButtonCommandsType string
+	MenuButtonCommandsType string `json:"type"`
 }
 
 type MenuButtonDefault struct {
-	MenuButtonDefaultType string
+	MenuButtonDefaultType string `json:"type"`
 }
 
 type MenuButtonWebApp struct {
-	MenuButtonWebAppType string
-	Text                 string
-	WebApp               WebAppInfo
+	MenuButtonWebAppType string     `json:"type"`
+	Text                 string     `json:"text"`
+	WebApp               WebAppInfo `json:"web_app"`
 }
 
-type ResponseParameters struct {
-	MigrateToChatID int64
-	RetryAfter      int64
-}
+type ResponseParameters = common.ResponseParameters
 
 type ProximityAlertTriggered struct {
-	Traveler User
-	Watcher  User
-	Distance int64
+	Traveler User  `json:"traveler"`
+	Watcher  User  `json:"watcher"`
+	Distance int64 `json:"distance"`
 }
 
 type ForumTopic struct {
-	MessageThreadID   int64
-	Name              string
-	IconColor         int64
-	IconCustomEmojiID string
+	MessageThreadID   int64  `json:"message_thread_id"`
+	Name              string `json:"name"`
+	IconColor         int64  `json:"icon_color"`
+	IconCustomEmojiID string `json:"icon_custom_emoji_id"`
 }
 
 type ForumTopicCreated struct {
-	Name              string
-	IconColor         int64
-	IconCustomEmojiID string
+	Name              string `json:"name"`
+	IconColor         int64  `json:"icon_color"`
+	IconCustomEmojiID string `json:"icon_custom_emoji_id"`
 }
 
 type ForumTopicEdited struct {
-	Name              string
-	IconCustomEmojiID string
+	Name              string `json:"name"`
+	IconCustomEmojiID string `json:"icon_custom_emoji_id"`
 }
 
 type ForumTopicClosed struct {
@@ -549,16 +570,16 @@ type VideoChatStarted struct {
 }
 
 type VideoChatEnded struct {
-	Duration int64
+	Duration int64 `json:"duration"`
 }
 
 type VideoChatParticipantsInvited struct {
-	Users []User
+	Users []User `json:"users"`
 }
 
 type WebAppData struct {
-	Data       string
-	ButtonText string
+	Data       string `json:"data"`
+	ButtonText string `json:"button_text"`
 }
 
 type InlineKeyboardMarkup struct {
@@ -566,34 +587,34 @@ type InlineKeyboardMarkup struct {
 }
 
 type LoginUrl struct {
-	URL                string
-	ForwardText        string
-	BotUsername        string
-	RequestWriteAccess bool
+	URL                string `json:"url"`
+	ForwardText        string `json:"forward_text,omitempty"`
+	BotUsername        string `json:"bot_username,omitempty"`
+	RequestWriteAccess bool   `json:"request_write_access,omitempty"`
 }
 
 type SwitchInlineQueryChosenChat struct {
-	Query             string
-	AllowUserChats    bool
-	AllowBotChats     bool
-	AllowGroupChats   bool
-	AllowChannelChats bool
+	Query             string `json:"query,omitempty"`
+	AllowUserChats    bool   `json:"allow_user_chats,omitempty"`
+	AllowBotChats     bool   `json:"allow_bot_chats,omitempty"`
+	AllowGroupChats   bool   `json:"allow_group_chats,omitempty"`
+	AllowChannelChats bool   `json:"allow_channel_chats,omitempty"`
 }
 
 type CallbackQuery struct {
-	ID              string
-	From            User
-	Message         Message
-	InlineMessageID string
-	ChatInstance    string
-	Data            string
-	GameShortName   string
+	ID              string  `json:"id"`
+	From            User    `json:"from"`
+	Message         Message `json:"message"`
+	InlineMessageID string  `json:"inline_message_id"`
+	ChatInstance    string  `json:"chat_instance"`
+	Data            string  `json:"data"`
+	GameShortName   string  `json:"game_short_name"`
 }
 
 type ForceReply struct {
-	ForceReply            bool
-	InputFieldPlaceholder string
-	Selective             bool
+	ForceReply            bool   `json:"force_reply"`
+	InputFieldPlaceholder string `json:"input_field_placeholder,omitempty"`
+	Selective             bool   `json:"selective,omitempty"`
 }
 
 type CallbackGame struct {
@@ -601,245 +622,258 @@ type CallbackGame struct {
 
 type InlineKeyboardButton struct {
 	Text                         string                       `json:"text"`
-	URL                          string                       `json:"url"`
-	CallbackData                 string                       `json:"callback_data"`
-	WebApp                       *WebAppInfo                  `json:"web_app"`
-	SwitchInlineQuery            string                       `json:"switch_inline_query"`
-	LoginURL                     *LoginUrl                    `json:"login_url"`
-	SwitchInlineQueryCurrentChat string                       `json:"switch_inline_query_current_chat"`
-	SwitchInlineQueryChosenChat  *SwitchInlineQueryChosenChat `json:"switch_inline_query_chosen_chat"`
-	CallbackGame                 *CallbackGame                `json:"callback_game"`
-	Pay                          bool                         `json:"pay"`
+	URL                          string                       `json:"url,omitempty"`
+	CallbackData                 string                       `json:"callback_data,omitempty"`
+	WebApp                       *WebAppInfo                  `json:"web_app,omitempty"`
+	SwitchInlineQuery            string                       `json:"switch_inline_query,omitempty"`
+	LoginURL                     *LoginUrl                    `json:"login_url,omitempty"`
+	SwitchInlineQueryCurrentChat string                       `json:"switch_inline_query_current_chat,omitempty"`
+	SwitchInlineQueryChosenChat  *SwitchInlineQueryChosenChat `json:"switch_inline_query_chosen_chat,omitempty"`
+	CallbackGame                 *CallbackGame                `json:"callback_game,omitempty"`
+	Pay                          bool                         `json:"pay,omitempty"`
 }
 
 type UserProfilePhotos struct {
-	TotalCount int64
-	Photos     [][]PhotoSize
+	TotalCount int64         `json:"total_count"`
+	Photos     [][]PhotoSize `json:"photos"`
+}
+
+type MessageID struct {
+	MessageID int64 `json:"message_id"`
 }
 
 type File struct {
-	FileID       string
-	FileUniqueID string
-	FileSize     int64
-	FilePath     string
+	FileID       string `json:"file_id"`
+	FileUniqueID string `json:"file_unique_id"`
+	FileSize     int64  `json:"file_size"`
+	FilePath     string `json:"file_path"`
 }
 
 type WebAppInfo struct {
-	URL string
+	URL string `json:"url"`
 }
 
 type ReplyKeyboardMarkup struct {
-	Keyboard              [][]KeyboardButton
-	IsPersistent          bool
-	ResizeKeyboard        bool
-	OneTimeKeyboard       bool
-	InputFieldPlaceholder string
-	Selective             bool
+	Keyboard              [][]KeyboardButton `json:"keyboard"`
+	IsPersistent          bool               `json:"is_persistent,omitempty"`
+	ResizeKeyboard        bool               `json:"resize_keyboard,omitempty"`
+	OneTimeKeyboard       bool               `json:"one_time_keyboard,omitempty"`
+	InputFieldPlaceholder string             `json:"input_field_placeholder,omitempty"`
+	Selective             bool               `json:"selective,omitempty"`
 }
 
 type KeyboardButton struct {
-	Text            string
-	RequestUser     KeyboardButtonRequestUser
-	RequestChat     KeyboardButtonRequestChat
-	RequestContact  bool
-	RequestLocation bool
-	RequestPoll     KeyboardButtonPollType
-	WebApp          WebAppInfo
+	Text            string                     `json:"text"`
+	RequestUser     *KeyboardButtonRequestUser `json:"request_user,omitempty"`
+	RequestChat     *KeyboardButtonRequestChat `json:"request_chat,omitempty"`
+	RequestContact  bool                       `json:"request_contact,omitempty"`
+	RequestLocation bool                       `json:"request_location,omitempty"`
+	RequestPoll     *KeyboardButtonPollType    `json:"request_poll,omitempty"`
+	WebApp          *WebAppInfo                `json:"web_app,omitempty"`
 }
 
 type KeyboardButtonRequestUser struct {
-	RequestID     int64
-	UserIsBot     bool
-	UserIsPremium bool
+	RequestID     int64 `json:"request_id"`
+	UserIsBot     bool  `json:"user_is_bot,omitempty"`
+	UserIsPremium bool  `json:"user_is_premium,omitempty"`
 }
 
 type KeyboardButtonRequestChat struct {
-	RequestID               int64
-	ChatIsChannel           bool
-	ChatIsForum             bool
-	ChatHasUsername         bool
-	ChatIsCreated           bool
-	UserAdministratorRights ChatAdministratorRights
-	BotAdministratorRights  ChatAdministratorRights
-	BotIsMember             bool
+	RequestID               int64                    `json:"request_id"`
+	ChatIsChannel           bool                     `json:"chat_is_channel"`
+	ChatIsForum             bool                     `json:"chat_is_forum,omitempty"`
+	ChatHasUsername         bool                     `json:"chat_has_username,omitempty"`
+	ChatIsCreated           bool                     `json:"chat_is_created,omitempty"`
+	UserAdministratorRights *ChatAdministratorRights `json:"user_administrator_rights,omitempty"`
+	BotAdministratorRights  *ChatAdministratorRights `json:"bot_administrator_rights,omitempty"`
+	BotIsMember             bool                     `json:"bot_is_member,omitempty"`
 }
 
 type KeyboardButtonPollType struct {
-	KeyboardButtonPollType string
+	KeyboardButtonPollType string `json:"type"`
 }
 
 type ChatAdministratorRights struct {
-	IsAnonymous         bool
-	CanManageChat       bool
-	CanDeleteMessages   bool
-	CanManageVideoChats bool
-	CanRestrictMembers  bool
-	CanPromoteMembers   bool
-	CanChangeInfo       bool
-	CanInviteUsers      bool
-	CanPostMessages     bool
-	CanEditMessages     bool
-	CanPinMessages      bool
-	CanPostStories      bool
-	CanEditStories      bool
-	CanDeleteStories    bool
-	CanManageTopics     bool
+	IsAnonymous         bool `json:"is_anonymous"`
+	CanManageChat       bool `json:"can_manage_chat"`
+	CanDeleteMessages   bool `json:"can_delete_messages"`
+	CanManageVideoChats bool `json:"can_manage_video_chats"`
+	CanRestrictMembers  bool `json:"can_restrict_members"`
+	CanPromoteMembers   bool `json:"can_promote_members"`
+	CanChangeInfo       bool `json:"can_change_info"`
+	CanInviteUsers      bool `json:"can_invite_users"`
+	CanPostMessages     bool `json:"can_post_messages"`
+	CanEditMessages     bool `json:"can_edit_messages"`
+	CanPinMessages      bool `json:"can_pin_messages"`
+	CanPostStories      bool `json:"can_post_stories"`
+	CanEditStories      bool `json:"can_edit_stories"`
+	CanDeleteStories    bool `json:"can_delete_stories"`
+	CanManageTopics     bool `json:"can_manage_topics"`
 }
 
 type ReplyKeyboardRemove struct {
-	RemoveKeyboard bool
-	Selective      bool
+	RemoveKeyboard bool `json:"remove_keyboard"`
+	Selective      bool `json:"selective,omitempty"`
 }
 
 type ChatMemberOwner struct {
-	Status      string
-	User        User
-	IsAnonymous bool
-	CustomTitle string
+	Status      string `json:"status"`
+	User        User   `json:"user"`
+	IsAnonymous bool   `json:"is_anonymous"`
+	CustomTitle string `json:"custom_title"`
 }
 
 type ChatMemberAdministrator struct {
-	Status              string
-	User                User
-	CanBeEdited         bool
-	IsAnonymous         bool
-	CanManageChat       bool
-	CanDeleteMessages   bool
-	CanManageVideoChats bool
-	CanRestrictMembers  bool
-	CanPromoteMembers   bool
-	CanChangeInfo       bool
-	CanInviteUsers      bool
-	CanPostMessages     bool
-	CanEditMessages     bool
-	CanPinMessages      bool
-	CanPostStories      bool
-	CanEditStories      bool
-	CanDeleteStories    bool
-	CanManageTopics     bool
-	CustomTitle         string
+	Status              string `json:"status"`
+	User                User   `json:"user"`
+	CanBeEdited         bool   `json:"can_be_edited"`
+	IsAnonymous         bool   `json:"is_anonymous"`
+	CanManageChat       bool   `json:"can_manage_chat"`
+	CanDeleteMessages   bool   `json:"can_delete_messages"`
+	CanManageVideoChats bool   `json:"can_manage_video_chats"`
+	CanRestrictMembers  bool   `json:"can_restrict_members"`
+	CanPromoteMembers   bool   `json:"can_promote_members"`
+	CanChangeInfo       bool   `json:"can_change_info"`
+	CanInviteUsers      bool   `json:"can_invite_users"`
+	CanPostMessages     bool   `json:"can_post_messages"`
+	CanEditMessages     bool   `json:"can_edit_messages"`
+	CanPinMessages      bool   `json:"can_pin_messages"`
+	CanPostStories      bool   `json:"can_post_stories"`
+	CanEditStories      bool   `json:"can_edit_stories"`
+	CanDeleteStories    bool   `json:"can_delete_stories"`
+	CanManageTopics     bool   `json:"can_manage_topics"`
+	CustomTitle         string `json:"custom_title"`
 }
 
 type ChatMemberRestricted struct {
-	Status                string
-	User                  User
-	IsMember              bool
-	CanSendMessages       bool
-	CanSendAudios         bool
-	CanSendDocuments      bool
-	CanSendPhotos         bool
-	CanSendVideos         bool
-	CanSendVideoNotes     bool
-	CanSendVoiceNotes     bool
-	CanSendPolls          bool
-	CanSendOtherMessages  bool
-	CanAddWebPagePreviews bool
-	CanChangeInfo         bool
-	CanInviteUsers        bool
-	CanPinMessages        bool
-	CanManageTopics       bool
-	UntilDate             int64
+	Status                string `json:"status"`
+	User                  User   `json:"user"`
+	IsMember              bool   `json:"is_member"`
+	CanSendMessages       bool   `json:"can_send_messages"`
+	CanSendAudios         bool   `json:"can_send_audios"`
+	CanSendDocuments      bool   `json:"can_send_documents"`
+	CanSendPhotos         bool   `json:"can_send_photos"`
+	CanSendVideos         bool   `json:"can_send_videos"`
+	CanSendVideoNotes     bool   `json:"can_send_video_notes"`
+	CanSendVoiceNotes     bool   `json:"can_send_voice_notes"`
+	CanSendPolls          bool   `json:"can_send_polls"`
+	CanSendOtherMessages  bool   `json:"can_send_other_messages"`
+	CanAddWebPagePreviews bool   `json:"can_add_web_page_previews"`
+	CanChangeInfo         bool   `json:"can_change_info"`
+	CanInviteUsers        bool   `json:"can_invite_users"`
+	CanPinMessages        bool   `json:"can_pin_messages"`
+	CanManageTopics       bool   `json:"can_manage_topics"`
+	UntilDate             int64  `json:"until_date"`
 }
 
 type ChatMemberMember struct {
-	Status string
-	User   User
+	Status string `json:"status"`
+	User   User   `json:"user"`
 }
 
 type ChatMemberLeft struct {
-	Status string
-	User   User
+	Status string `json:"status"`
+	User   User   `json:"user"`
 }
 
 type ChatMemberBanned struct {
-	Status    string
-	User      User
-	UntilDate int64
+	Status    string `json:"status"`
+	User      User   `json:"user"`
+	UntilDate int64  `json:"until_date"`
 }
 
 type ChatMemberUpdated struct {
-	Chat                    Chat
-	From                    User
-	Date                    int64
-	OldChatMember           ChatMember
-	NewChatMember           ChatMember
-	InviteLink              ChatInviteLink
-	ViaChatFolderInviteLink bool
+	Chat                    Chat           `json:"chat"`
+	From                    User           `json:"from"`
+	Date                    int64          `json:"date"`
+	OldChatMember           ChatMember     `json:"old_chat_member"`
+	NewChatMember           ChatMember     `json:"new_chat_member"`
+	InviteLink              ChatInviteLink `json:"invite_link"`
+	ViaChatFolderInviteLink bool           `json:"via_chat_folder_invite_link"`
 }
 
 type ChatJoinRequest struct {
-	Chat       Chat
-	From       User
-	UserChatID int64
-	Date       int64
-	Bio        string
-	InviteLink ChatInviteLink
+	Chat       Chat           `json:"chat"`
+	From       User           `json:"from"`
+	UserChatID int64          `json:"user_chat_id"`
+	Date       int64          `json:"date"`
+	Bio        string         `json:"bio"`
+	InviteLink ChatInviteLink `json:"invite_link"`
 }
 
 type ChatMember struct {
+	Status      string `json:"status"`
+	User        User   `json:"user"`
+	IsAnonymous bool   `json:"is_anonymous"`
+	CustomTitle string `json:"custom_title"`
+	IsMember    bool   `json:"is_member"`
+	UntilDate   int64  `json:"until_date"`
 }
 
 type InputMediaPhoto struct {
-	InputMediaPhotoType string
-	Media               string
-	Caption             string
-	ParseMode           string
-	CaptionEntities     []MessageEntity
-	HasSpoiler          bool
+	InputMediaPhotoType string          `json:"type"`
+	Media               string          `json:"media"`
+	MediaFile           InputFile       `json:"-"`
+	Caption             string          `json:"caption,omitempty"`
+	ParseMode           string          `json:"parse_mode,omitempty"`
+	CaptionEntities     []MessageEntity `json:"caption_entities,omitempty"`
+	HasSpoiler          bool            `json:"has_spoiler,omitempty"`
 }
 
 type InputMediaVideo struct {
-	InputMediaVideoType string
-	Media               string
-	Thumbnail           InputFile
-	Caption             string
-	ParseMode           string
-	CaptionEntities     []MessageEntity
-	Width               int64
-	Height              int64
-	Duration            int64
-	SupportsStreaming   bool
-	HasSpoiler          bool
+	InputMediaVideoType string          `json:"type"`
+	Media               string          `json:"media"`
+	MediaFile           InputFile       `json:"-"`
+	Thumbnail           InputFile       `json:"-"`
+	Caption             string          `json:"caption,omitempty"`
+	ParseMode           string          `json:"parse_mode,omitempty"`
+	CaptionEntities     []MessageEntity `json:"caption_entities,omitempty"`
+	Width               int64           `json:"width,omitempty"`
+	Height              int64           `json:"height,omitempty"`
+	Duration            int64           `json:"duration,omitempty"`
+	SupportsStreaming   bool            `json:"supports_streaming,omitempty"`
+	HasSpoiler          bool            `json:"has_spoiler,omitempty"`
 }
 
 type InputMediaAnimation struct {
-	InputMediaVideoType string
-	Media               string
-	Thumbnail           InputFile
-	Caption             string
-	ParseMode           string
-	CaptionEntities     []MessageEntity
-	Width               int64
-	Height              int64
-	Duration            int64
-	HasSpoiler          bool
+	InputMediaVideoType string          `json:"type"`
+	Media               string          `json:"media"`
+	MediaFile           InputFile       `json:"-"`
+	Thumbnail           InputFile       `json:"-"`
+	Caption             string          `json:"caption,omitempty"`
+	ParseMode           string          `json:"parse_mode,omitempty"`
+	CaptionEntities     []MessageEntity `json:"caption_entities,omitempty"`
+	Width               int64           `json:"width,omitempty"`
+	Height              int64           `json:"height,omitempty"`
+	Duration            int64           `json:"duration,omitempty"`
+	HasSpoiler          bool            `json:"has_spoiler,omitempty"`
 }
 
 type InputMediaAudio struct {
-	InputMediaVideoType string
-	Media               string
-	Thumbnail           InputFile
-	Caption             string
-	ParseMode           string
-	CaptionEntities     []MessageEntity
-	Width               int64
-	Height              int64
-	Duration            int64
-	Performer           string
-	HasSpoiler          bool
+	InputMediaVideoType string          `json:"type"`
+	Media               string          `json:"media"`
+	MediaFile           InputFile       `json:"-"`
+	Thumbnail           InputFile       `json:"-"`
+	Caption             string          `json:"caption,omitempty"`
+	ParseMode           string          `json:"parse_mode,omitempty"`
+	CaptionEntities     []MessageEntity `json:"caption_entities,omitempty"`
+	Width               int64           `json:"width,omitempty"`
+	Height              int64           `json:"height,omitempty"`
+	Duration            int64           `json:"duration,omitempty"`
+	Performer           string          `json:"performer,omitempty"`
+	Title               string          `json:"title,omitempty"`
+	HasSpoiler          bool            `json:"has_spoiler,omitempty"`
 }
 
 type InputMediaDocument struct {
-	InputMediaVideoType         string
-	Media                       string
-	Thumbnail                   InputFile
-	Caption                     string
-	ParseMode                   string
-	CaptionEntities             []MessageEntity
-	DisableContentTypeDetection bool
-}
-
-type InputFile struct {
+	InputMediaVideoType         string          `json:"type"`
+	Media                       string          `json:"media"`
+	MediaFile                   InputFile       `json:"-"`
+	Thumbnail                   InputFile       `json:"-"`
+	Caption                     string          `json:"caption,omitempty"`
+	ParseMode                   string          `json:"parse_mode,omitempty"`
+	CaptionEntities             []MessageEntity `json:"caption_entities,omitempty"`
+	DisableContentTypeDetection bool            `json:"disable_content_type_detection,omitempty"`
 }
 
 type InlineQuery struct {
@@ -903,3 +937,21 @@ type WebhookInfo struct {
 	MaxConnections               int      `json:"max_connections"`
 	AllowedUpdates               []string `json:"allowed_updates"`
 }
+
+type Update struct {
+	UpdateID           int64               `json:"update_id"`
+	Message            *Message            `json:"message"`
+	EditedMessage      *Message            `json:"edited_message"`
+	ChannelPost        *Message            `json:"channel_post"`
+	EditedChannelPost  *Message            `json:"edited_channel_post"`
+	InlineQuery        *InlineQuery        `json:"inline_query"`
+	ChosenInlineResult *ChosenInlineResult `json:"chosen_inline_result"`
+	CallbackQuery      *CallbackQuery      `json:"callback_query"`
+	ShippingQuery      *ShippingQuery      `json:"shipping_query"`
+	PreCheckoutQuery   *PreCheckoutQuery   `json:"pre_checkout_query"`
+	Poll               *Poll               `json:"poll"`
+	PollAnswer         *PollAnswer         `json:"poll_answer"`
+	MyChatMember       *ChatMemberUpdated  `json:"my_chat_member"`
+	ChatMember         *ChatMemberUpdated  `json:"chat_member"`
+	ChatJoinRequest    *ChatJoinRequest    `json:"chat_join_request"`
+}
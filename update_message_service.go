@@ -3,7 +3,6 @@ package telegram
 import (
 	"context"
 	"net/http"
-	"telegram/common"
 
 	jsoniter "github.com/json-iterator/go"
 )
@@ -43,9 +42,10 @@ func (t *EditMessageReplyMarkupService) ReplyMarkup(replyMarkup InlineKeyboardMa
 	return t
 }
 
-func (t *EditMessageReplyMarkupService) Do(ctx context.Context, opts ...RequestOption) (res []*EditMessageReplyMarkup, err error) {
+// Do returns the edited message, or nil when an inline message was edited.
+func (t *EditMessageReplyMarkupService) Do(ctx context.Context, opts ...RequestOption) (res *Message, err error) {
 	r := &request{
-		method:   http.MethodGet,
+		method:   http.MethodPost,
 		endpoint: "/editMessageReplyMarkup",
 	}
 
@@ -66,22 +66,7 @@ func (t *EditMessageReplyMarkupService) Do(ctx context.Context, opts ...RequestO
 	if err != nil {
 		return nil, err
 	}
-	data = common.ToJSONList(data)
-	if err != nil {
-		return nil, err
-	}
-
-	res = make([]*EditMessageReplyMarkup, 0)
-	err = json.Unmarshal(data, &res)
-	if err != nil {
-		return nil, err
-	}
-	return res, nil
-}
-
-type EditMessageReplyMarkup struct {
-	Ok     bool    `json:"ok"`
-	Result Message `json:"result"`
+	return decodeEditResult(data)
 }
 
 type EditMessageTextService struct {
@@ -150,9 +135,10 @@ func (t *EditMessageTextService) ReplyMarkup(replyMarkup InlineKeyboardMarkup) *
 	return t
 }
 
-func (t *EditMessageTextService) Do(ctx context.Context, opts ...RequestOption) (res []*EditMessageText, err error) {
+// Do returns the edited message, or nil when an inline message was edited.
+func (t *EditMessageTextService) Do(ctx context.Context, opts ...RequestOption) (res *Message, err error) {
 	r := &request{
-		method:   http.MethodGet,
+		method:   http.MethodPost,
 		endpoint: "/editMessageText",
 	}
 
@@ -184,22 +170,7 @@ func (t *EditMessageTextService) Do(ctx context.Context, opts ...RequestOption)
 	if err != nil {
 		return nil, err
 	}
-	data = common.ToJSONList(data)
-	if err != nil {
-		return nil, err
-	}
-
-	res = make([]*EditMessageText, 0)
-	err = json.Unmarshal(data, &res)
-	if err != nil {
-		return nil, err
-	}
-	return res, nil
-}
-
-type EditMessageText struct {
-	Ok     bool    `json:"ok"`
-	Result Message `json:"result"`
+	return decodeEditResult(data)
 }
 
 type DeleteMessageService struct {
@@ -218,9 +189,9 @@ func (t *DeleteMessageService) MessageID(messageID int64) *DeleteMessageService
 	return t
 }
 
-func (t *DeleteMessageService) Do(ctx context.Context, opts ...RequestOption) (res []*DeleteMessage, err error) {
+func (t *DeleteMessageService) Do(ctx context.Context, opts ...RequestOption) (res bool, err error) {
 	r := &request{
-		method:   http.MethodGet,
+		method:   http.MethodPost,
 		endpoint: "/deleteMessage",
 	}
 
@@ -230,22 +201,7 @@ func (t *DeleteMessageService) Do(ctx context.Context, opts ...RequestOption) (r
 	data, err := t.c.callAPI(ctx, r, opts...)
 
 	if err != nil {
-		return nil, err
-	}
-	data = common.ToJSONList(data)
-	if err != nil {
-		return nil, err
-	}
-
-	res = make([]*DeleteMessage, 0)
-	err = json.Unmarshal(data, &res)
-	if err != nil {
-		return nil, err
+		return false, err
 	}
-	return res, nil
-}
-
-type DeleteMessage struct {
-	Ok     bool `json:"ok"`
-	Result bool `json:"result"`
+	return decodeResult[bool](data)
 }
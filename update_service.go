@@ -0,0 +1,174 @@
+package telegram
+
+import (
+	"context"
+	"net/http"
+)
+
+const (
+	UpdateTypeMessage            = "message"
+	UpdateTypeEditedMessage      = "edited_message"
+	UpdateTypeChannelPost        = "channel_post"
+	UpdateTypeEditedChannelPost  = "edited_channel_post"
+	UpdateTypeInlineQuery        = "inline_query"
+	UpdateTypeChosenInlineResult = "chosen_inline_result"
+	UpdateTypeCallbackQuery      = "callback_query"
+	UpdateTypeShippingQuery      = "shipping_query"
+	UpdateTypePreCheckoutQuery   = "pre_checkout_query"
+	UpdateTypePoll               = "poll"
+	UpdateTypePollAnswer         = "poll_answer"
+	UpdateTypeMyChatMember       = "my_chat_member"
+	UpdateTypeChatMember         = "chat_member"
+	UpdateTypeChatJoinRequest    = "chat_join_request"
+)
+
+type GetUpdatesService struct {
+	c              *Client
+	offset         *int64
+	limit          *int64
+	timeout        *int64
+	allowedUpdates []string
+}
+
+func (t *GetUpdatesService) Offset(offset int64) *GetUpdatesService {
+	t.offset = &offset
+	return t
+}
+
+func (t *GetUpdatesService) Limit(limit int64) *GetUpdatesService {
+	t.limit = &limit
+	return t
+}
+
+func (t *GetUpdatesService) Timeout(timeout int64) *GetUpdatesService {
+	t.timeout = &timeout
+	return t
+}
+
+func (t *GetUpdatesService) AllowedUpdates(allowedUpdates []string) *GetUpdatesService {
+	t.allowedUpdates = allowedUpdates
+	return t
+}
+
+func (t *GetUpdatesService) Do(ctx context.Context, opts ...RequestOption) (res []Update, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/getUpdates",
+	}
+
+	if t.offset != nil {
+		r.setParam("offset", *t.offset)
+	}
+	if t.limit != nil {
+		r.setParam("limit", *t.limit)
+	}
+	if t.timeout != nil {
+		r.setParam("timeout", *t.timeout)
+	}
+	if t.allowedUpdates != nil {
+		r.setParam("allowed_updates", t.allowedUpdates)
+	}
+
+	data, err := t.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return decodeResult[[]Update](data)
+}
+
+func (u *Update) EffectiveMessage() *Message {
+	switch {
+	case u.Message != nil:
+		return u.Message
+	case u.EditedMessage != nil:
+		return u.EditedMessage
+	case u.ChannelPost != nil:
+		return u.ChannelPost
+	case u.EditedChannelPost != nil:
+		return u.EditedChannelPost
+	case u.CallbackQuery != nil && u.CallbackQuery.Message.MessageID != 0:
+		return &u.CallbackQuery.Message
+	}
+	return nil
+}
+
+func (u *Update) EffectiveChatID() int64 {
+	if message := u.EffectiveMessage(); message != nil {
+		return message.Chat.ID
+	}
+	switch {
+	case u.MyChatMember != nil:
+		return u.MyChatMember.Chat.ID
+	case u.ChatMember != nil:
+		return u.ChatMember.Chat.ID
+	case u.ChatJoinRequest != nil:
+		return u.ChatJoinRequest.Chat.ID
+	case u.PollAnswer != nil:
+		return u.PollAnswer.VoterChat.ID
+	}
+	return 0
+}
+
+func (u *Update) EffectiveUser() *User {
+	switch {
+	case u.Message != nil:
+		return &u.Message.From
+	case u.EditedMessage != nil:
+		return &u.EditedMessage.From
+	case u.CallbackQuery != nil:
+		return &u.CallbackQuery.From
+	case u.InlineQuery != nil:
+		return &u.InlineQuery.From
+	case u.ChosenInlineResult != nil:
+		return &u.ChosenInlineResult.From
+	case u.ShippingQuery != nil:
+		return &u.ShippingQuery.From
+	case u.PreCheckoutQuery != nil:
+		return &u.PreCheckoutQuery.From
+	case u.PollAnswer != nil:
+		return &u.PollAnswer.User
+	case u.MyChatMember != nil:
+		return &u.MyChatMember.From
+	case u.ChatMember != nil:
+		return &u.ChatMember.From
+	case u.ChatJoinRequest != nil:
+		return &u.ChatJoinRequest.From
+	}
+	return nil
+}
+
+// Type reports which kind of update u carries, as one of the UpdateType
+// constants, or an empty string for kinds this package does not decode.
+func (u *Update) Type() string {
+	switch {
+	case u.Message != nil:
+		return UpdateTypeMessage
+	case u.EditedMessage != nil:
+		return UpdateTypeEditedMessage
+	case u.ChannelPost != nil:
+		return UpdateTypeChannelPost
+	case u.EditedChannelPost != nil:
+		return UpdateTypeEditedChannelPost
+	case u.InlineQuery != nil:
+		return UpdateTypeInlineQuery
+	case u.ChosenInlineResult != nil:
+		return UpdateTypeChosenInlineResult
+	case u.CallbackQuery != nil:
+		return UpdateTypeCallbackQuery
+	case u.ShippingQuery != nil:
+		return UpdateTypeShippingQuery
+	case u.PreCheckoutQuery != nil:
+		return UpdateTypePreCheckoutQuery
+	case u.Poll != nil:
+		return UpdateTypePoll
+	case u.PollAnswer != nil:
+		return UpdateTypePollAnswer
+	case u.MyChatMember != nil:
+		return UpdateTypeMyChatMember
+	case u.ChatMember != nil:
+		return UpdateTypeChatMember
+	case u.ChatJoinRequest != nil:
+		return UpdateTypeChatJoinRequest
+	}
+	return ""
+}
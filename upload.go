@@ -0,0 +1,137 @@
+package telegram
+
+import (
+	"fmt"
+	"io"
+	"mime/multipart"
+	"os"
+	"sort"
+)
+
+// UploadProgressFunc is called as the bytes of an uploaded file are sent.
+// total is -1 when the size of the file is not known in advance.
+type UploadProgressFunc func(field string, sent, total int64)
+
+func WithUploadProgress(progress UploadProgressFunc) RequestOption {
+	return func(r *request) {
+		r.progress = progress
+	}
+}
+
+type openFile struct {
+	field string
+	name  string
+	size  int64
+	src   io.ReadCloser
+}
+
+// multipartBody streams the request parameters and files through a pipe so
+// that uploads are never held in memory as a whole. Files are opened up front
+// so that a missing path is reported before the HTTP request is sent.
+func (r *request) multipartBody() (body io.ReadCloser, contentType string, err error) {
+	files := make([]openFile, 0, len(r.files))
+	for _, f := range r.files {
+		src, err := f.file.open()
+		if err != nil {
+			closeFiles(files)
+			return nil, "", err
+		}
+		files = append(files, openFile{
+			field: f.field,
+			name:  f.file.fileName(),
+			size:  f.file.size(),
+			src:   src,
+		})
+	}
+
+	pr, pw := io.Pipe()
+	w := multipart.NewWriter(pw)
+
+	go func() {
+		defer closeFiles(files)
+		// A panic here could not be recovered by the caller and would take
+		// down the process, so it fails the request instead.
+		defer func() {
+			if p := recover(); p != nil {
+				pw.CloseWithError(fmt.Errorf("writing multipart body: %v", p))
+			}
+		}()
+		pw.CloseWithError(r.writeMultipart(w, files))
+	}()
+
+	return pr, w.FormDataContentType(), nil
+}
+
+func (r *request) writeMultipart(w *multipart.Writer, files []openFile) error {
+	values := r.values()
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		err := w.WriteField(key, values.Get(key))
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, f := range files {
+		part, err := w.CreateFormFile(f.field, f.name)
+		if err != nil {
+			return err
+		}
+		var src io.Reader = f.src
+		if r.progress != nil {
+			src = &progressReader{r: f.src, field: f.field, total: f.size, progress: r.progress}
+		}
+		_, err = io.Copy(part, src)
+		if err != nil {
+			return err
+		}
+	}
+
+	return w.Close()
+}
+
+func closeFiles(files []openFile) {
+	for _, f := range files {
+		f.src.Close()
+	}
+}
+
+func (f InputFile) size() int64 {
+	switch {
+	case f.Path != "":
+		info, err := os.Stat(f.Path)
+		if err != nil {
+			return -1
+		}
+		return info.Size()
+	case f.Reader != nil:
+		if l, ok := f.Reader.(interface{ Len() int }); ok {
+			return int64(l.Len())
+		}
+		return -1
+	case f.Bytes != nil:
+		return int64(len(f.Bytes))
+	}
+	return -1
+}
+
+type progressReader struct {
+	r        io.Reader
+	field    string
+	sent     int64
+	total    int64
+	progress UploadProgressFunc
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	if n > 0 {
+		p.sent += int64(n)
+		p.progress(p.field, p.sent, p.total)
+	}
+	return n, err
+}
@@ -3,7 +3,6 @@ package telegram
 import (
 	"context"
 	"net/http"
-	"telegram/common"
 )
 
 type GetUserProfilePhotosService struct {
@@ -28,9 +27,9 @@ func (t *GetUserProfilePhotosService) Limit(limit int64) *GetUserProfilePhotosSe
 	return t
 }
 
-func (t *GetUserProfilePhotosService) Do(ctx context.Context, opts ...RequestOption) (res []*GetUserProfilePhotos, err error) {
+func (t *GetUserProfilePhotosService) Do(ctx context.Context, opts ...RequestOption) (res *UserProfilePhotos, err error) {
 	r := &request{
-		method:   http.MethodGet,
+		method:   http.MethodPost,
 		endpoint: "/getUserProfilePhotos",
 	}
 
@@ -46,20 +45,5 @@ func (t *GetUserProfilePhotosService) Do(ctx context.Context, opts ...RequestOpt
 	if err != nil {
 		return nil, err
 	}
-	data = common.ToJSONList(data)
-	if err != nil {
-		return nil, err
-	}
-
-	res = make([]*GetUserProfilePhotos, 0)
-	err = json.Unmarshal(data, &res)
-	if err != nil {
-		return nil, err
-	}
-	return res, nil
-}
-
-type GetUserProfilePhotos struct {
-	Ok     bool    `json:"ok"`
-	Result Message `json:"result"`
+	return decodeResult[*UserProfilePhotos](data)
 }
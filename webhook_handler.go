@@ -0,0 +1,121 @@
+package telegram
+
+import (
+	"context"
+	"crypto/subtle"
+	"net/http"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+const secretTokenHeader = "X-Telegram-Bot-Api-Secret-Token"
+
+const maxWebhookBodySize = 1 << 20
+
+// WebhookHandler receives updates pushed by Telegram to the URL registered
+// with SetWebhookService. When Client.SecretKey is set, requests without a
+// matching X-Telegram-Bot-Api-Secret-Token header are rejected.
+type WebhookHandler struct {
+	c       *Client
+	handler UpdateHandler
+}
+
+func (c *Client) NewWebhookHandler(handler UpdateHandler) *WebhookHandler {
+	return &WebhookHandler{c: c, handler: handler}
+}
+
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	if !h.authorized(r) {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	update := new(Update)
+	err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxWebhookBodySize)).Decode(update)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	// Trace context sent by a proxy in front of the bot carries over to the
+	// handler and the calls it makes.
+	ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+	reply := &webhookReply{}
+	ctx = context.WithValue(ctx, webhookReplyKey{}, reply)
+
+	// Telegram redelivers updates that are not answered with 2xx, so handler
+	// errors are only logged to avoid replaying the same update forever.
+	err = h.c.handleUpdate(ctx, h.handler, update)
+	if err != nil {
+		h.c.logger().Error("telegram: webhook handler failed", "update_id", update.UpdateID, "error", err)
+	}
+
+	body := reply.body()
+	if body == nil {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func (h *WebhookHandler) authorized(r *http.Request) bool {
+	if h.c.SecretKey == "" {
+		return true
+	}
+	token := r.Header.Get(secretTokenHeader)
+	return subtle.ConstantTimeCompare([]byte(token), []byte(h.c.SecretKey)) == 1
+}
+
+type webhookReplyKey struct{}
+
+type webhookReply struct {
+	mu     sync.Mutex
+	method string
+	params params
+}
+
+func (r *webhookReply) body() params {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.method == "" {
+		return nil
+	}
+	body := params{}
+	for k, v := range r.params {
+		body[k] = v
+	}
+	body["method"] = r.method
+	return body
+}
+
+// ReplyWithMethod answers the webhook request that delivered the current
+// update with a Bot API call, saving a separate HTTP request. Telegram does
+// not report the result of such calls. Only one reply can be sent per update;
+// it returns false when ctx does not come from a WebhookHandler or a reply
+// has already been set.
+func ReplyWithMethod(ctx context.Context, method string, params map[string]any) bool {
+	reply, ok := ctx.Value(webhookReplyKey{}).(*webhookReply)
+	if !ok {
+		return false
+	}
+
+	reply.mu.Lock()
+	defer reply.mu.Unlock()
+
+	if reply.method != "" {
+		return false
+	}
+	reply.method = method
+	reply.params = params
+	return true
+}
@@ -3,15 +3,12 @@ package telegram
 import (
 	"context"
 	"net/http"
-	"telegram/common"
-
-	jsoniter "github.com/json-iterator/go"
 )
 
 type SetWebhookService struct {
 	c                  *Client
 	url                *string
-	certificate        *string
+	certificate        *InputFile
 	ipAddress          *string
 	maxConnections     *int64
 	allowedUpdates     *string
@@ -25,14 +22,7 @@ func (t *SetWebhookService) URL(url string) *SetWebhookService {
 }
 
 func (t *SetWebhookService) Certificate(certificate InputFile) *SetWebhookService {
-	json, err := jsoniter.Marshal(&certificate)
-	if err != nil {
-		return nil
-	}
-
-	jsonString := string(json)
-
-	t.certificate = &jsonString
+	t.certificate = &certificate
 	return t
 }
 
@@ -61,15 +51,15 @@ func (t *SetWebhookService) SecretToken(secretToken string) *SetWebhookService {
 	return t
 }
 
-func (t *SetWebhookService) Do(ctx context.Context, opts ...RequestOption) (res []*SetWebhook, err error) {
+func (t *SetWebhookService) Do(ctx context.Context, opts ...RequestOption) (res bool, err error) {
 	r := &request{
-		method:   http.MethodGet,
+		method:   http.MethodPost,
 		endpoint: "/setWebhook",
 	}
 
 	r.setParam("url", *t.url)
 	if t.certificate != nil {
-		r.setParam("certificate", *t.certificate)
+		r.setFile("certificate", *t.certificate)
 	}
 	if t.ipAddress != nil {
 		r.setParam("ip_address", *t.ipAddress)
@@ -89,28 +79,10 @@ func (t *SetWebhookService) Do(ctx context.Context, opts ...RequestOption) (res
 
 	data, err := t.c.callAPI(ctx, r, opts...)
 	if err != nil {
-		return nil, err
-	}
-
-	data = common.ToJSONList(data)
-	if err != nil {
-		return nil, err
+		return false, err
 	}
 
-	res = make([]*SetWebhook, 0)
-
-	err = json.Unmarshal(data, &res)
-	if err != nil {
-		return nil, err
-	}
-
-	return res, nil
-}
-
-type SetWebhook struct {
-	Ok          bool   `json:"ok"`
-	Result      bool   `json:"result"`
-	Description string `json:"description"`
+	return decodeResult[bool](data)
 }
 
 type DeleteWebhookService struct {
@@ -123,9 +95,9 @@ func (t *DeleteWebhookService) DropPendingUpdates(dropPendingUpdates bool) *Dele
 	return t
 }
 
-func (t *DeleteWebhookService) Do(ctx context.Context, opts ...RequestOption) (res []*DeleteWebhook, err error) {
+func (t *DeleteWebhookService) Do(ctx context.Context, opts ...RequestOption) (res bool, err error) {
 	r := &request{
-		method:   http.MethodGet,
+		method:   http.MethodPost,
 		endpoint: "/deleteWebhook",
 	}
 
@@ -135,37 +107,19 @@ func (t *DeleteWebhookService) Do(ctx context.Context, opts ...RequestOption) (r
 
 	data, err := t.c.callAPI(ctx, r, opts...)
 	if err != nil {
-		return nil, err
-	}
-
-	data = common.ToJSONList(data)
-	if err != nil {
-		return nil, err
-	}
-
-	res = make([]*DeleteWebhook, 0)
-
-	err = json.Unmarshal(data, &res)
-	if err != nil {
-		return nil, err
+		return false, err
 	}
 
-	return res, nil
-}
-
-type DeleteWebhook struct {
-	Ok          bool   `json:"ok"`
-	Result      bool   `json:"result"`
-	Description string `json:"description"`
+	return decodeResult[bool](data)
 }
 
 type GetWebhookInfoService struct {
 	c *Client
 }
 
-func (t *GetWebhookInfoService) Do(ctx context.Context, opts ...RequestOption) (res []*GetWebhookInfo, err error) {
+func (t *GetWebhookInfoService) Do(ctx context.Context, opts ...RequestOption) (res *WebhookInfo, err error) {
 	r := &request{
-		method:   http.MethodGet,
+		method:   http.MethodPost,
 		endpoint: "/getWebhookInfo",
 	}
 
@@ -173,20 +127,5 @@ func (t *GetWebhookInfoService) Do(ctx context.Context, opts ...RequestOption) (
 	if err != nil {
 		return nil, err
 	}
-	data = common.ToJSONList(data)
-	if err != nil {
-		return nil, err
-	}
-
-	res = make([]*GetWebhookInfo, 0)
-	err = json.Unmarshal(data, &res)
-	if err != nil {
-		return nil, err
-	}
-	return res, nil
-}
-
-type GetWebhookInfo struct {
-	Ok     bool        `json:"ok"`
-	Result WebhookInfo `json:"result"`
+	return decodeResult[*WebhookInfo](data)
 }